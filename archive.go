@@ -0,0 +1,134 @@
+package elite
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// OpenArchive opens a zip or tar.gz archive of a log directory, such as one
+// attached to a bug report, as a file system that can be passed to WithFS:
+//
+//     fsys, err := elite.OpenArchive("journals.zip")
+//     if err != nil {
+//         return err
+//     }
+//     client := elite.NewClient(elite.WithFS(fsys))
+//
+// The archive is read into memory. If the journal files are in a folder
+// inside the archive, rather than at its root, that folder is returned.
+func OpenArchive(name string) (fs.FS, error) {
+	var read func([]byte) (fs.FS, error)
+	switch lower := strings.ToLower(name); {
+	case strings.HasSuffix(lower, ".zip"):
+		read = readZip
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		read = readTarGz
+	default:
+		return nil, errors.New("Unsupported archive format: " + name)
+	}
+
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	fsys, err := read(content)
+	if err != nil {
+		return nil, fmt.Errorf("Couldn't read archive %s: %w", name, err)
+	}
+
+	return logDirFS(fsys)
+}
+
+func readZip(content []byte) (fs.FS, error) {
+	return zip.NewReader(bytes.NewReader(content), int64(len(content)))
+}
+
+// readTarGz converts a tar.gz archive to an uncompressed zip archive in
+// memory, since the tar format has no index to open files from.
+func readTarGz(content []byte) (fs.FS, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     strings.TrimPrefix(path.Clean(header.Name), "/"),
+			Method:   zip.Store,
+			Modified: header.ModTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(w, tr); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+}
+
+// logDirFS returns the shallowest directory in fsys that contains a journal
+// file or Status.json, or fsys itself if there is none.
+func logDirFS(fsys fs.FS) (fs.FS, error) {
+	dir := ""
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if _, ok := ParseJournalFileName(entry.Name()); !ok && entry.Name() != "Status.json" {
+			return nil
+		}
+
+		if parent := path.Dir(name); dir == "" || depth(parent) < depth(dir) {
+			dir = parent
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if dir == "" || dir == "." {
+		return fsys, nil
+	}
+	return fs.Sub(fsys, dir)
+}
+
+// depth returns how many directories deep a slash-separated directory name is.
+func depth(dir string) int {
+	if dir == "." {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
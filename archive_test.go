@@ -0,0 +1,131 @@
+package elite_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func writeZip(t *testing.T, name string, journals map[string][]string) {
+	file, err := os.Create(name)
+	if err != nil {
+		fmt.Println("Couldn't create zip: " + err.Error())
+		t.FailNow()
+	}
+	defer file.Close()
+
+	zw := zip.NewWriter(file)
+	for journal, lines := range journals {
+		w, err := zw.Create("Elite Dangerous/" + journal)
+		if err != nil {
+			fmt.Println("Couldn't add to zip: " + err.Error())
+			t.FailNow()
+		}
+		w.Write([]byte(strings.Join(lines, "\n") + "\n"))
+	}
+	if err := zw.Close(); err != nil {
+		fmt.Println("Couldn't write zip: " + err.Error())
+		t.FailNow()
+	}
+}
+
+func writeTarGz(t *testing.T, name string, journals map[string][]string) {
+	file, err := os.Create(name)
+	if err != nil {
+		fmt.Println("Couldn't create tar.gz: " + err.Error())
+		t.FailNow()
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	for journal, lines := range journals {
+		content := []byte(strings.Join(lines, "\n") + "\n")
+		header := &tar.Header{Name: journal, Mode: 0644, Size: int64(len(content)), ModTime: time.Now()}
+		if err := tw.WriteHeader(header); err != nil {
+			fmt.Println("Couldn't add to tar: " + err.Error())
+			t.FailNow()
+		}
+		tw.Write(content)
+	}
+	tw.Close()
+	if err := gz.Close(); err != nil {
+		fmt.Println("Couldn't write tar.gz: " + err.Error())
+		t.FailNow()
+	}
+}
+
+func TestOpenArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	zipPath := filepath.Join(dir, "journals.zip")
+	tarPath := filepath.Join(dir, "journals.tar.gz")
+	writeZip(t, zipPath, historyJournals)
+	writeTarGz(t, tarPath, historyJournals)
+
+	for _, name := range []string{zipPath, tarPath} {
+		fsys, err := elite.OpenArchive(name)
+		if err != nil {
+			fmt.Println("Couldn't open archive: " + err.Error())
+			t.FailNow()
+		}
+		client := elite.NewClient(elite.WithFS(fsys))
+
+		journal, err := client.OpenJournal(elite.JournalFilter{Events: []string{"FSDJump"}})
+		if err != nil {
+			fmt.Println("Couldn't open journal: " + err.Error())
+			t.FailNow()
+		}
+		var systems []string
+		for journal.Next() {
+			systems = append(systems, journal.Event().(*elite.FSDJump).StarSystem)
+		}
+		journal.Close()
+		if journal.Err() != nil || strings.Join(systems, ",") != "Sol,Alpha Centauri,Lave" {
+			fmt.Printf("Unexpected jumps from %s: %v (%v)\n", filepath.Base(name), systems, journal.Err())
+			t.FailNow()
+		}
+
+		// Files in a zip archive can't seek, so the index has to read up to each event.
+		index, err := client.OpenIndex(filepath.Join(dir, "index.json"))
+		if err != nil {
+			fmt.Println("Couldn't open index: " + err.Error())
+			t.FailNow()
+		}
+		entry, ok := index.Last("MarketSell")
+		if !ok {
+			fmt.Println("Expected a MarketSell entry in the index")
+			t.FailNow()
+		}
+		event, err := index.ReadEvent(entry)
+		if err != nil {
+			fmt.Println("Couldn't read event: " + err.Error())
+			t.FailNow()
+		}
+		if sell := event.(*elite.MarketSell); sell.Type != "gold" || sell.Count != 1 {
+			fmt.Printf("Unexpected MarketSell event: %+v\n", sell)
+			t.FailNow()
+		}
+	}
+
+	rarPath := filepath.Join(dir, "journals.rar")
+	ioutil.WriteFile(rarPath, []byte("Rar!"), 0644)
+	if _, err := elite.OpenArchive(rarPath); err == nil || !strings.Contains(err.Error(), "Unsupported") {
+		fmt.Printf("Expected an unsupported archive error, got %v\n", err)
+		t.FailNow()
+	}
+}
@@ -0,0 +1,204 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// MicroResource is a quantity of an on-foot item, component, consumable or data.
+// OwnerID and MissionID are set for resources that belong to another player
+// or to a mission.
+type MicroResource struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	OwnerID       int64  `json:"OwnerID"`
+	MissionID     int64  `json:"MissionID,omitempty"`
+	Count         int64  `json:"Count"`
+}
+
+// Backpack lists the micro-resources carried by the player on foot.
+type Backpack struct {
+	*JournalEntry
+	Items       []MicroResource `json:"Items"`
+	Components  []MicroResource `json:"Components"`
+	Consumables []MicroResource `json:"Consumables"`
+	Data        []MicroResource `json:"Data"`
+}
+
+// Count returns how many of the named micro-resource are in the backpack.
+// The name may be either the game's internal name or the localised name,
+// and is matched without regard to case.
+func (backpack *Backpack) Count(name string) int64 {
+	return countMicroResources(name, backpack.Items, backpack.Components, backpack.Consumables, backpack.Data)
+}
+
+// ShipLocker lists the micro-resources stored in the ship's locker.
+type ShipLocker struct {
+	*JournalEntry
+	Items       []MicroResource `json:"Items"`
+	Components  []MicroResource `json:"Components"`
+	Consumables []MicroResource `json:"Consumables"`
+	Data        []MicroResource `json:"Data"`
+}
+
+// Count returns how many of the named micro-resource are in the ship locker.
+// The name may be either the game's internal name or the localised name,
+// and is matched without regard to case.
+func (locker *ShipLocker) Count(name string) int64 {
+	return countMicroResources(name, locker.Items, locker.Components, locker.Consumables, locker.Data)
+}
+
+func countMicroResources(name string, lists ...[]MicroResource) int64 {
+	var count int64
+	for _, list := range lists {
+		for _, resource := range list {
+			if strings.EqualFold(resource.Name, name) || strings.EqualFold(resource.NameLocalised, name) {
+				count += resource.Count
+			}
+		}
+	}
+	return count
+}
+
+// OnFootInventory combines the backpack and ship locker, which together
+// hold all of the player's micro-resources.
+type OnFootInventory struct {
+	Backpack   *Backpack
+	ShipLocker *ShipLocker
+}
+
+// Count returns how many of the named micro-resource are held across the
+// backpack and ship locker. Either may be nil.
+func (inventory *OnFootInventory) Count(name string) int64 {
+	var count int64
+	if inventory.Backpack != nil {
+		count += inventory.Backpack.Count(name)
+	}
+	if inventory.ShipLocker != nil {
+		count += inventory.ShipLocker.Count(name)
+	}
+	return count
+}
+
+// Totals returns the quantity of every micro-resource held across the
+// backpack and ship locker, keyed by the game's internal name.
+func (inventory *OnFootInventory) Totals() map[string]int64 {
+	var lists [][]MicroResource
+	if inventory.Backpack != nil {
+		lists = append(lists, inventory.Backpack.Items, inventory.Backpack.Components, inventory.Backpack.Consumables, inventory.Backpack.Data)
+	}
+	if inventory.ShipLocker != nil {
+		lists = append(lists, inventory.ShipLocker.Items, inventory.ShipLocker.Components, inventory.ShipLocker.Consumables, inventory.ShipLocker.Data)
+	}
+
+	totals := map[string]int64{}
+	for _, list := range lists {
+		for _, resource := range list {
+			totals[resource.Name] += resource.Count
+		}
+	}
+	return totals
+}
+
+// GetOnFootInventory reads the backpack and ship locker from Backpack.json and ShipLocker.json.
+// It will read them from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetOnFootInventoryFromPath.
+func GetOnFootInventory() (*OnFootInventory, error) {
+	return GetOnFootInventoryFromPath(defaultLogPath())
+}
+
+// GetOnFootInventoryFromPath reads the backpack and ship locker from Backpack.json and ShipLocker.json at the specified log path.
+func GetOnFootInventoryFromPath(logPath string) (*OnFootInventory, error) {
+	return NewClient(WithLogPath(logPath)).GetOnFootInventory()
+}
+
+// GetOnFootInventory reads the backpack and ship locker from Backpack.json and ShipLocker.json.
+func (c *Client) GetOnFootInventory() (*OnFootInventory, error) {
+	backpack, err := c.GetBackpack()
+	if err != nil {
+		return nil, err
+	}
+	locker, err := c.GetShipLocker()
+	if err != nil {
+		return nil, err
+	}
+
+	return &OnFootInventory{Backpack: backpack, ShipLocker: locker}, nil
+}
+
+// GetBackpack reads the micro-resources carried on foot from Backpack.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetBackpackFromPath.
+func GetBackpack() (*Backpack, error) {
+	return GetBackpackFromPath(defaultLogPath())
+}
+
+// GetBackpackFromPath reads the micro-resources carried on foot from Backpack.json at the specified log path.
+func GetBackpackFromPath(logPath string) (*Backpack, error) {
+	return NewClient(WithLogPath(logPath)).GetBackpack()
+}
+
+// GetBackpack reads the micro-resources carried on foot from Backpack.json.
+func (c *Client) GetBackpack() (*Backpack, error) {
+	var backpack *Backpack
+	err := c.readCompanionFile("Backpack.json", func(content []byte) (err error) {
+		backpack, err = GetBackpackFromBytes(content)
+		return err
+	})
+	return backpack, err
+}
+
+// GetBackpackFromBytes reads the micro-resources carried on foot from the string contained in the byte array.
+func GetBackpackFromBytes(content []byte) (*Backpack, error) {
+	backpack := &Backpack{}
+	if err := json.Unmarshal(content, backpack); err != nil {
+		return nil, errors.New("Couldn't unmarshal Backpack.json file: " + err.Error())
+	}
+
+	return backpack, nil
+}
+
+// GetShipLocker reads the micro-resources stored in the ship locker from ShipLocker.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetShipLockerFromPath.
+func GetShipLocker() (*ShipLocker, error) {
+	return GetShipLockerFromPath(defaultLogPath())
+}
+
+// GetShipLockerFromPath reads the micro-resources stored in the ship locker from ShipLocker.json at the specified log path.
+func GetShipLockerFromPath(logPath string) (*ShipLocker, error) {
+	return NewClient(WithLogPath(logPath)).GetShipLocker()
+}
+
+// GetShipLocker reads the micro-resources stored in the ship locker from ShipLocker.json.
+func (c *Client) GetShipLocker() (*ShipLocker, error) {
+	var locker *ShipLocker
+	err := c.readCompanionFile("ShipLocker.json", func(content []byte) (err error) {
+		locker, err = GetShipLockerFromBytes(content)
+		return err
+	})
+	return locker, err
+}
+
+// GetShipLockerFromBytes reads the micro-resources stored in the ship locker from the string contained in the byte array.
+func GetShipLockerFromBytes(content []byte) (*ShipLocker, error) {
+	locker := &ShipLocker{}
+	if err := json.Unmarshal(content, locker); err != nil {
+		return nil, errors.New("Couldn't unmarshal ShipLocker.json file: " + err.Error())
+	}
+
+	return locker, nil
+}
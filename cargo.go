@@ -0,0 +1,61 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// CargoItem is a quantity of a single commodity in the ship or SRV's cargo hold.
+type CargoItem struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Count         int64  `json:"Count"`
+	Stolen        int64  `json:"Stolen"`
+	MissionID     int64  `json:"MissionID,omitempty"`
+}
+
+// Cargo lists the contents of the cargo hold. Vessel is either "Ship" or "SRV".
+// It is written both as a journal event and to Cargo.json, although
+// recent versions of the game only include the Inventory in Cargo.json.
+type Cargo struct {
+	*JournalEntry
+	Vessel    string      `json:"Vessel"`
+	Count     int64       `json:"Count"`
+	Inventory []CargoItem `json:"Inventory"`
+}
+
+// GetCargo reads the contents of the ship's cargo hold from Cargo.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetCargoFromPath.
+func GetCargo() (*Cargo, error) {
+	return GetCargoFromPath(defaultLogPath())
+}
+
+// GetCargoFromPath reads the contents of the ship's cargo hold from Cargo.json at the specified log path.
+func GetCargoFromPath(logPath string) (*Cargo, error) {
+	return NewClient(WithLogPath(logPath)).GetCargo()
+}
+
+// GetCargo reads the contents of the ship's cargo hold from Cargo.json.
+func (c *Client) GetCargo() (*Cargo, error) {
+	var cargo *Cargo
+	err := c.readCompanionFile("Cargo.json", func(content []byte) (err error) {
+		cargo, err = GetCargoFromBytes(content)
+		return err
+	})
+	return cargo, err
+}
+
+// GetCargoFromBytes reads the contents of the ship's cargo hold from the string contained in the byte array.
+func GetCargoFromBytes(content []byte) (*Cargo, error) {
+	cargo := &Cargo{}
+	if err := json.Unmarshal(content, cargo); err != nil {
+		return nil, errors.New("Couldn't unmarshal Cargo.json file: " + err.Error())
+	}
+
+	return cargo, nil
+}
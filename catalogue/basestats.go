@@ -0,0 +1,340 @@
+package catalogue
+
+import (
+	"fmt"
+	"math"
+)
+
+// internalFamily describes how the base stats of one kind of internal
+// module vary with its size and rating. Like the game's own outfitting
+// data, most families are given by their class C values for each size,
+// scaled to the other ratings.
+type internalFamily struct {
+	// symbol is the format of the module's symbol, given its size and
+	// class, or only its size for modules without a rating.
+	symbol string
+	sizes  []int
+	// mass and power are the class C values by size.
+	mass  map[int]float64
+	power map[int]float64
+	// massScale holds the ratings the module comes in, and scales mass to
+	// each of them. powerScale does the same for power, and is 1 if nil.
+	massScale  map[string]float64
+	powerScale map[string]float64
+	// integrity scales integrities. Modules without integrity have zero.
+	integrity float64
+}
+
+// classMasses are the mass in tonnes of most class C internal modules, by size.
+var classMasses = map[int]float64{1: 1.3, 2: 2.5, 3: 5, 4: 10, 5: 20, 6: 40, 7: 80, 8: 160}
+
+var (
+	// standardMass and standardPower scale class C values to each rating
+	// for most internal modules.
+	standardMass  = map[string]float64{"E": 1, "D": 0.4, "C": 1, "B": 1.6, "A": 1}
+	standardPower = map[string]float64{"E": 0.8, "D": 0.9, "C": 1, "B": 1.1, "A": 1.2}
+	// powerPlantMass is relative to classMasses, as power plants are half
+	// the mass of other core modules at C and A.
+	powerPlantMass = map[string]float64{"E": 1, "D": 0.4, "C": 0.5, "B": 0.8, "A": 0.5}
+	fsdPower       = map[string]float64{"E": 0.8, "D": 0.9, "C": 1, "B": 1.25, "A": 1.5}
+
+	onlyE         = map[string]float64{"E": 1}
+	onlyC         = map[string]float64{"C": 1}
+	unrated       = map[string]float64{"": 1}
+	reinforcement = map[string]float64{"E": 1, "D": 0.5}
+)
+
+// integrities are the integrity of most rated internal modules, by size and rating.
+var integrities = map[int]map[string]float64{
+	1: {"E": 32, "D": 29, "C": 36, "B": 43, "A": 40},
+	2: {"E": 46, "D": 41, "C": 51, "B": 61, "A": 56},
+	3: {"E": 58, "D": 51, "C": 64, "B": 77, "A": 70},
+	4: {"E": 72, "D": 64, "C": 80, "B": 96, "A": 88},
+	5: {"E": 86, "D": 77, "C": 96, "B": 115, "A": 106},
+	6: {"E": 102, "D": 90, "C": 113, "B": 136, "A": 124},
+	7: {"E": 118, "D": 105, "C": 131, "B": 157, "A": 144},
+	8: {"E": 135, "D": 120, "C": 150, "B": 180, "A": 165},
+}
+
+var (
+	coreSizes      = []int{2, 3, 4, 5, 6, 7, 8}
+	allSizes       = []int{1, 2, 3, 4, 5, 6, 7, 8}
+	limpetSizes    = []int{1, 3, 5, 7}
+	reinforceSizes = []int{1, 2, 3, 4, 5}
+
+	lifeSupportPower = map[int]float64{1: 0.4, 2: 0.46, 3: 0.52, 4: 0.62, 5: 0.71, 6: 0.8, 7: 0.9, 8: 1.0}
+	thrusterPower    = map[int]float64{2: 2.5, 3: 3.1, 4: 4.1, 5: 5.1, 6: 6.3, 7: 7.6, 8: 9.0}
+	fsdClassPower    = map[int]float64{2: 0.2, 3: 0.3, 4: 0.3, 5: 0.4, 6: 0.5, 7: 0.6, 8: 0.7}
+	shieldPower      = map[int]float64{1: 0.6, 2: 0.9, 3: 1.2, 4: 1.55, 5: 1.9, 6: 2.3, 7: 2.7, 8: 3.1}
+	reinforceMasses  = map[int]float64{1: 2, 2: 4, 3: 8, 4: 16, 5: 32}
+	limpetMasses     = map[int]float64{1: 1.3, 3: 2, 5: 8, 7: 32}
+	limpetPower      = map[int]float64{1: 0.18, 3: 0.27, 5: 0.41, 7: 0.55}
+)
+
+// internalFamilies are the families of internal modules with base stats.
+var internalFamilies = []internalFamily{
+	{symbol: "int_powerplant_size%d_class%d", sizes: coreSizes, mass: classMasses, massScale: powerPlantMass, integrity: 1},
+	{symbol: "int_guardianpowerplant_size%d", sizes: coreSizes, massScale: unrated,
+		mass: map[int]float64{2: 1.5, 3: 2.9, 4: 5.9, 5: 11.7, 6: 23.4, 7: 46.8, 8: 93.6}},
+	{symbol: "int_engine_size%d_class%d", sizes: coreSizes, mass: classMasses, power: thrusterPower,
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_engine_size%d_class%d_fast", sizes: []int{2, 3}, mass: classMasses, power: thrusterPower,
+		massScale: map[string]float64{"D": 0.4, "A": 1}, powerScale: standardPower, integrity: 1},
+	{symbol: "int_hyperdrive_size%d_class%d", sizes: []int{2, 3, 4, 5, 6, 7}, mass: classMasses, power: fsdClassPower,
+		massScale: standardMass, powerScale: fsdPower, integrity: 1.13},
+	{symbol: "int_hyperdrive_overcharge_size%d_class%d", sizes: coreSizes, mass: classMasses, power: fsdClassPower,
+		massScale: standardMass, powerScale: fsdPower, integrity: 1.13},
+	{symbol: "int_lifesupport_size%d_class%d", sizes: allSizes, mass: classMasses, power: lifeSupportPower,
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_powerdistributor_size%d_class%d", sizes: allSizes, mass: classMasses,
+		power:     map[int]float64{1: 0.4, 2: 0.45, 3: 0.5, 4: 0.56, 5: 0.62, 6: 0.68, 7: 0.74, 8: 0.8},
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_guardianpowerdistributor_size%d", sizes: allSizes, massScale: unrated,
+		mass:  map[int]float64{1: 1.4, 2: 2.6, 3: 5.25, 4: 10.5, 5: 21, 6: 42, 7: 84, 8: 168},
+		power: map[int]float64{1: 0.62, 2: 0.73, 3: 0.78, 4: 0.87, 5: 0.96, 6: 1.07, 7: 1.16, 8: 1.25}},
+	{symbol: "int_sensors_size%d_class%d", sizes: allSizes, mass: classMasses,
+		power:     map[int]float64{1: 0.18, 2: 0.23, 3: 0.28, 4: 0.34, 5: 0.41, 6: 0.5, 7: 0.59, 8: 0.69},
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_fueltank_size%d_class%d", sizes: allSizes, massScale: onlyC},
+
+	{symbol: "int_cargorack_size%d_class%d", sizes: allSizes, massScale: onlyE},
+	{symbol: "int_corrosionproofcargorack_size%d_class%d", sizes: []int{1, 4, 5, 6}, massScale: onlyE},
+	{symbol: "int_shieldgenerator_size%d_class%d", sizes: coreSizes, mass: classMasses, power: shieldPower,
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_shieldgenerator_size%d_class%d_fast", sizes: coreSizes, mass: classMasses, power: shieldPower,
+		massScale: onlyC, powerScale: map[string]float64{"C": 1.2}, integrity: 1},
+	{symbol: "int_shieldgenerator_size%d_class%d_strong", sizes: coreSizes, mass: classMasses, power: shieldPower,
+		massScale: map[string]float64{"A": 1.5}, powerScale: map[string]float64{"A": 1.75}, integrity: 1},
+	{symbol: "int_shieldcellbank_size%d_class%d", sizes: allSizes, mass: classMasses,
+		power:     map[int]float64{1: 0.41, 2: 0.55, 3: 0.69, 4: 0.83, 5: 0.97, 6: 1.1, 7: 1.24, 8: 1.38},
+		massScale: standardMass, powerScale: standardPower, integrity: 1},
+	{symbol: "int_hullreinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement},
+	{symbol: "int_metaalloyhullreinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement},
+	{symbol: "int_modulereinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement},
+	{symbol: "int_guardianhullreinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement,
+		power: map[int]float64{1: 0.45, 2: 0.56, 3: 0.67, 4: 0.78, 5: 0.89}},
+	{symbol: "int_guardianmodulereinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement,
+		power: map[int]float64{1: 0.27, 2: 0.34, 3: 0.41, 4: 0.48, 5: 0.55}},
+	{symbol: "int_guardianshieldreinforcement_size%d_class%d", sizes: reinforceSizes, mass: reinforceMasses, massScale: reinforcement,
+		power: map[int]float64{1: 0.35, 2: 0.46, 3: 0.56, 4: 0.67, 5: 0.84}},
+	{symbol: "int_fuelscoop_size%d_class%d", sizes: allSizes, massScale: standardMass, powerScale: standardPower, integrity: 1,
+		power: map[int]float64{1: 0.27, 2: 0.33, 3: 0.38, 4: 0.43, 5: 0.5, 6: 0.58, 7: 0.65, 8: 0.75}},
+	{symbol: "int_refinery_size%d_class%d", sizes: []int{1, 2, 3, 4}, massScale: standardMass, powerScale: standardPower, integrity: 1,
+		power: map[int]float64{1: 0.36, 2: 0.45, 3: 0.54, 4: 0.63}},
+	{symbol: "int_repairer_size%d_class%d", sizes: allSizes, massScale: standardMass, powerScale: standardPower, integrity: 1,
+		power: map[int]float64{1: 0.54, 2: 0.68, 3: 0.81, 4: 0.99, 5: 1.17, 6: 1.4, 7: 1.58, 8: 1.8}},
+	{symbol: "int_fsdinterdictor_size%d_class%d", sizes: []int{1, 2, 3, 4}, mass: classMasses, massScale: standardMass,
+		power: map[int]float64{1: 0.23, 2: 0.28, 3: 0.33, 4: 0.38}, powerScale: standardPower, integrity: 1},
+	{symbol: "int_guardianfsdbooster_size%d", sizes: reinforceSizes, massScale: unrated,
+		mass:  map[int]float64{1: 1.3, 2: 1.3, 3: 1.3, 4: 1.3, 5: 1.3},
+		power: map[int]float64{1: 0.75, 2: 0.98, 3: 1.27, 4: 1.65, 5: 2.14}},
+	{symbol: "int_buggybay_size%d_class%d", sizes: []int{2, 4, 6},
+		mass: map[int]float64{2: 12, 4: 20, 6: 34}, massScale: reinforcement,
+		power: map[int]float64{2: 0.6, 4: 0.75, 6: 0.9}, powerScale: map[string]float64{"E": 1, "D": 1.3}},
+	{symbol: "int_fighterbay_size%d_class%d", sizes: []int{5, 6, 7}, massScale: onlyE,
+		mass: map[int]float64{5: 20, 6: 40, 7: 60}, power: map[int]float64{5: 0.25, 6: 0.35, 7: 0.35}},
+	{symbol: "int_passengercabin_size%d_class%d", sizes: []int{2, 3, 4, 5, 6}, mass: classMasses,
+		massScale: map[string]float64{"E": 1, "D": 1, "C": 1, "B": 1}},
+	limpetController("collection"),
+	limpetController("fueltransfer"),
+	limpetController("prospector"),
+	limpetController("repair"),
+	limpetController("resourcesiphon"),
+}
+
+// limpetController returns the family of the limpet controllers that come
+// in every rating, such as "int_dronecontrol_collection_size1_class1".
+func limpetController(kind string) internalFamily {
+	return internalFamily{
+		symbol: "int_dronecontrol_" + kind + "_size%d_class%d", sizes: limpetSizes,
+		mass: limpetMasses, power: limpetPower, massScale: standardMass, powerScale: standardPower, integrity: 1,
+	}
+}
+
+// utilityStats are the base stats of utilities with a rating, by the part
+// of the symbol between "hpt_" and the size, and rating.
+var utilityStats = map[string]map[string]Stats{
+	"shieldbooster": {
+		"E": {Mass: 0.5, PowerDraw: 0.2, Integrity: 25},
+		"D": {Mass: 1, PowerDraw: 0.5, Integrity: 35},
+		"C": {Mass: 2, PowerDraw: 0.7, Integrity: 40},
+		"B": {Mass: 3, PowerDraw: 1, Integrity: 45},
+		"A": {Mass: 3.5, PowerDraw: 1.2, Integrity: 48},
+	},
+	"cloudscanner": scannerStats,
+	"cargoscanner": scannerStats,
+	"crimescanner": scannerStats,
+	"mrascanner":   scannerStats,
+}
+
+// scannerStats are the base stats of the wake, manifest, kill warrant and
+// pulse wave scanners, by rating.
+var scannerStats = map[string]Stats{
+	"E": {Mass: 1.3, PowerDraw: 0.2, Integrity: 32},
+	"D": {Mass: 1.3, PowerDraw: 0.4, Integrity: 24},
+	"C": {Mass: 1.3, PowerDraw: 0.8, Integrity: 40},
+	"B": {Mass: 1.3, PowerDraw: 1.6, Integrity: 56},
+	"A": {Mass: 1.3, PowerDraw: 3.2, Integrity: 48},
+}
+
+// weaponPower is the power draw in megawatts of each hardpoint, by the part
+// of the symbol between "hpt_" and the mount, then by mount, from small to
+// huge. Zero means the hardpoint doesn't come in that size.
+var weaponPower = map[string]map[string][4]float64{
+	"pulselaser": {
+		"fixed":  {0.39, 0.6, 0.9, 1.33},
+		"gimbal": {0.39, 0.6, 0.92, 1.37},
+		"turret": {0.38, 0.58, 0.89},
+	},
+	"pulselaserburst": {
+		"fixed":  {0.65, 1.05, 1.66, 2.58},
+		"gimbal": {0.64, 1.04, 1.65, 2.59},
+		"turret": {0.6, 0.98, 1.57},
+	},
+	"beamlaser": {
+		"fixed":  {0.62, 1.01, 1.62, 2.61},
+		"gimbal": {0.6, 1, 1.6, 2.57},
+		"turret": {0.57, 0.93, 1.51},
+	},
+	"multicannon": {
+		"fixed":  {0.28, 0.46, 0.69, 0.73},
+		"gimbal": {0.37, 0.64, 0.97, 1.22},
+		"turret": {0.26, 0.5, 0.86},
+	},
+	"cannon": {
+		"fixed":  {0.34, 0.49, 0.67, 0.92},
+		"gimbal": {0.38, 0.54, 0.75, 1.03},
+		"turret": {0.32, 0.45, 0.64},
+	},
+	"slugshot": {
+		"fixed":  {0.45, 0.74, 1.02},
+		"gimbal": {0.59, 1.03, 1.55},
+		"turret": {0.42, 0.79, 1.29},
+	},
+	"railgun":                 {"fixed": {1.15, 1.63}},
+	"plasmaaccelerator":       {"fixed": {0, 1.43, 1.97, 2.63}},
+	"dumbfiremissilerack":     {"fixed": {0.4, 1.2, 1.62}},
+	"basicmissilerack":        {"fixed": {0.6, 1.2, 1.62}},
+	"drunkmissilerack":        {"fixed": {0.93, 1.2, 1.79}},
+	"advancedtorppylon":       {"fixed": {0.4, 0.4, 0.6}},
+	"minelauncher":            {"fixed": {0.4, 0.4}},
+	"mininglaser":             {"fixed": {0.5, 0.75}, "turret": {0.5, 0.75}},
+	"mining_abrblstr":         {"fixed": {0.34}, "turret": {0.47}},
+	"mining_seismchrgwarhd":   {"fixed": {0, 1.2}, "turret": {0, 1.2}},
+	"mining_subsurfdispmisle": {"fixed": {0.5, 1.01}, "turret": {0.5, 0.93}},
+	"flakmortar":              {"fixed": {0, 1.2}, "turret": {0, 1.2}},
+	"flechettelauncher":       {"fixed": {0, 1.2}, "turret": {0, 1.2}},
+	"guardian_gausscannon":    {"fixed": {1.91, 2.61}},
+	"guardian_plasmalauncher": {"fixed": {1.4, 2.13, 3.1}, "turret": {1.6, 2.01, 2.53}},
+	"guardian_shardcannon":    {"fixed": {0.72, 1.21, 1.68}, "turret": {0.57, 1.16, 1.39}},
+	"atdumbfiremissile":       {"fixed": {0, 1.2, 1.75}, "turret": {0, 1.2, 1.75}},
+	"atmulticannon":           {"fixed": {0, 0.46, 0.64}, "turret": {0, 0.5, 0.69}},
+}
+
+// weaponMasses and weaponIntegrities are the mass in tonnes and integrity
+// of hardpoints, by size.
+var (
+	weaponMasses      = map[int]float64{1: 2, 2: 4, 3: 8, 4: 16}
+	weaponIntegrities = map[int]float64{1: 40, 2: 51, 3: 64, 4: 80}
+)
+
+// symbolStats are the base stats of the modules that only come in one
+// form, by symbol.
+var symbolStats = map[string]Stats{
+	"int_dockingcomputer_standard":                  {PowerDraw: 0.39, Integrity: 10},
+	"int_dockingcomputer_advanced":                  {PowerDraw: 0.45, Integrity: 10},
+	"int_supercruiseassist":                         {PowerDraw: 0.3, Integrity: 10},
+	"int_detailedsurfacescanner_tiny":               {Integrity: 20},
+	"int_stellarbodydiscoveryscanner_standard":      {Mass: 2, Integrity: 24},
+	"int_planetapproachsuite":                       {},
+	"int_planetapproachsuite_advanced":              {},
+	"int_codexscanner":                              {},
+	"int_dronecontrol_decontamination_size1_class3": {Mass: 1.3, PowerDraw: 0.18, Integrity: 36},
+	"int_dronecontrol_decontamination_size3_class3": {Mass: 2, PowerDraw: 0.2, Integrity: 64},
+	"int_dronecontrol_decontamination_size5_class3": {Mass: 20, PowerDraw: 0.5, Integrity: 96},
+	"int_dronecontrol_decontamination_size7_class3": {Mass: 128, PowerDraw: 0.97, Integrity: 131},
+	"int_dronecontrol_recon_size1_class1":           {Mass: 1.3, PowerDraw: 0.18, Integrity: 32},
+	"int_dronecontrol_recon_size3_class1":           {Mass: 2, PowerDraw: 0.2, Integrity: 58},
+	"int_dronecontrol_recon_size5_class1":           {Mass: 20, PowerDraw: 0.5, Integrity: 86},
+	"int_dronecontrol_recon_size7_class1":           {Mass: 128, PowerDraw: 0.97, Integrity: 118},
+	"int_dronecontrol_unkvesselresearch":            {Mass: 1.3, PowerDraw: 0.4, Integrity: 20},
+	"int_multidronecontrol_mining_size3_class3":     {Mass: 12, PowerDraw: 0.5, Integrity: 45},
+	"int_multidronecontrol_operations_size3_class4": {Mass: 15, PowerDraw: 0.5, Integrity: 48},
+	"int_multidronecontrol_rescue_size3_class2":     {Mass: 8, PowerDraw: 0.4, Integrity: 40},
+	"int_multidronecontrol_xeno_size3_class3":       {Mass: 10, PowerDraw: 0.35, Integrity: 45},
+	"int_multidronecontrol_universal_size7_class5":  {Mass: 125, PowerDraw: 0.8, Integrity: 200},
+	"int_expmodulestabiliser_size3_class3":          {Mass: 8, PowerDraw: 1.5, Integrity: 64},
+	"int_expmodulestabiliser_size5_class3":          {Mass: 20, PowerDraw: 3, Integrity: 96},
+
+	"hpt_heatsinklauncher_turret_tiny":   {Mass: 1.3, PowerDraw: 0.2, Integrity: 45},
+	"hpt_chafflauncher_tiny":             {Mass: 1.3, PowerDraw: 0.2, Integrity: 20},
+	"hpt_plasmapointdefence_turret_tiny": {Mass: 0.5, PowerDraw: 0.2, Integrity: 30},
+	"hpt_electroniccountermeasure_tiny":  {Mass: 1.3, PowerDraw: 0.2, Integrity: 20},
+	"hpt_antiunknownshutdown_tiny":       {Mass: 1.3, PowerDraw: 0.2, Integrity: 35},
+	"hpt_xenoscanner_basic_tiny":         {Mass: 1.3, PowerDraw: 0.2, Integrity: 56},
+	"modularcargobaydoor":                {PowerDraw: 0.6},
+	"modularcargobaydoorfdl":             {PowerDraw: 0.6},
+}
+
+// baseStats builds the base stats of every module in the tables, by symbol.
+func baseStats() map[string]Stats {
+	all := map[string]Stats{}
+	for symbol, s := range symbolStats {
+		all[symbol] = s
+	}
+
+	for _, family := range internalFamilies {
+		for _, size := range family.sizes {
+			for rating, massScale := range family.massScale {
+				powerScale := 1.0
+				if family.powerScale != nil {
+					powerScale = family.powerScale[rating]
+				}
+				s := Stats{
+					Mass:      round(family.mass[size]*massScale, 1),
+					PowerDraw: round(family.power[size]*powerScale, 2),
+					Integrity: round(integrities[size][rating]*family.integrity, 0),
+				}
+				if rating == "" {
+					all[fmt.Sprintf(family.symbol, size)] = s
+				} else {
+					all[fmt.Sprintf(family.symbol, size, ratingClass(rating))] = s
+				}
+			}
+		}
+	}
+
+	for group, byRating := range utilityStats {
+		for rating, s := range byRating {
+			all[fmt.Sprintf("hpt_%s_size0_class%d", group, ratingClass(rating))] = s
+		}
+	}
+	for group, byMount := range weaponPower {
+		for mount, power := range byMount {
+			for sizeName, size := range hardpointSizes {
+				if size == 0 || power[size-1] == 0 {
+					continue
+				}
+				symbol := fmt.Sprintf("hpt_%s_%s_%s", group, mount, sizeName)
+				all[symbol] = Stats{Mass: weaponMasses[size], PowerDraw: power[size-1], Integrity: weaponIntegrities[size]}
+			}
+		}
+	}
+	return all
+}
+
+// ratingClass returns the class in a symbol of a rating, from 1 for "E" to 5 for "A".
+func ratingClass(rating string) int {
+	for i, r := range ratings {
+		if r == rating {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// round rounds x to the given number of decimal places, as the game shows them.
+func round(x float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(x*scale) / scale
+}
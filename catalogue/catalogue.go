@@ -0,0 +1,273 @@
+// Package catalogue decodes the symbols the game uses for ships and modules,
+// such as "int_hyperdrive_size5_class5" and "krait_light", without needing
+// any online data.
+package catalogue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Category is the kind of slot a module is fitted to.
+type Category string
+
+const (
+	// CategoryCore is a core internal module, such as the power plant.
+	CategoryCore Category = "Core"
+	// CategoryOptional is an optional internal module, such as a cargo rack.
+	CategoryOptional Category = "Optional"
+	// CategoryHardpoint is a weapon or mining tool.
+	CategoryHardpoint Category = "Hardpoint"
+	// CategoryUtility is a utility mount, such as a shield booster.
+	CategoryUtility Category = "Utility"
+	// CategoryArmour is the ship's bulkheads.
+	CategoryArmour Category = "Armour"
+	// CategoryStructure is a fixed part of the ship, such as the cockpit or cargo hatch.
+	CategoryStructure Category = "Structure"
+	// CategoryCosmetic is a paint job, decal, ship kit or similar.
+	CategoryCosmetic Category = "Cosmetic"
+)
+
+// Item describes a module decoded from its symbol.
+type Item struct {
+	// Symbol is the normalised symbol, such as "int_hyperdrive_size5_class5".
+	Symbol string
+	// Name is the display name, such as "5A Frame Shift Drive".
+	Name string
+	// Group is the display name of the kind of module, such as "Frame Shift Drive".
+	Group    string
+	Category Category
+	// Size is the module's class, from 0 for utilities to 8.
+	// It is -1 for modules without a size.
+	Size int
+	// Rating is the module's rating, from "E" to "A", or empty for modules without one.
+	Rating string
+	// Mount is "Fixed", "Gimballed" or "Turreted" for hardpoints.
+	Mount string
+	// Stats are the module's base stats, or nil if they aren't in the catalogue.
+	Stats *Stats
+}
+
+// Ship describes a ship decoded from its symbol.
+type Ship struct {
+	Symbol string
+	Name   string
+	// Pad is the landing pad size the ship needs: "S", "M" or "L".
+	Pad string
+}
+
+var ratings = [...]string{"E", "D", "C", "B", "A"}
+
+var hardpointSizes = map[string]int{"tiny": 0, "small": 1, "medium": 2, "large": 3, "huge": 4}
+
+var hardpointSizeNames = map[int]string{0: "Tiny", 1: "Small", 2: "Medium", 3: "Large", 4: "Huge"}
+
+var mounts = map[string]string{"fixed": "Fixed", "gimbal": "Gimballed", "turret": "Turreted"}
+
+var armourGrades = map[string]string{
+	"grade1":   "Lightweight Alloy",
+	"grade2":   "Reinforced Alloy",
+	"grade3":   "Military Grade Composite",
+	"mirrored": "Mirrored Surface Composite",
+	"reactive": "Reactive Surface Composite",
+}
+
+var cosmeticPrefixes = []string{
+	"paintjob_", "decal_", "nameplate_", "voicepack_", "bobble_", "weaponcustomisation_",
+	"enginecustomisation_", "string_lights_",
+}
+
+// coreSlots are the slots written in loadouts that take core internal modules.
+var coreSlots = map[string]bool{
+	"PowerPlant":       true,
+	"MainEngines":      true,
+	"FrameShiftDrive":  true,
+	"LifeSupport":      true,
+	"PowerDistributor": true,
+	"Radar":            true,
+	"FuelTank":         true,
+}
+
+// Normalise converts a symbol to the form used by the catalogue. Symbols are
+// written as "$int_cargorack_size4_class1_name;" in some events and
+// "Int_CargoRack_Size4_Class1" in others.
+func Normalise(symbol string) string {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	symbol = strings.TrimPrefix(symbol, "$")
+	return strings.TrimSuffix(symbol, "_name;")
+}
+
+// Lookup decodes a module symbol. It returns false if the kind of module
+// isn't known, in which case only the fields that could be decoded from
+// the symbol itself are set, and Name is the symbol.
+func Lookup(symbol string) (Item, bool) {
+	symbol = Normalise(symbol)
+	item := Item{Symbol: symbol, Name: symbol, Size: -1, Stats: lookupStats(symbol)}
+
+	switch {
+	case strings.HasPrefix(symbol, "int_"):
+		return decodeInternal(item)
+	case strings.HasPrefix(symbol, "hpt_"):
+		return decodeHardpoint(item)
+	case strings.Contains(symbol, "_armour_"):
+		i := strings.Index(symbol, "_armour_")
+		ship, shipOK := LookupShip(symbol[:i])
+		grade, gradeOK := armourGrades[symbol[i+len("_armour_"):]]
+		item.Category = CategoryArmour
+		if !shipOK || !gradeOK {
+			return item, false
+		}
+		item.Group = grade
+		item.Name = ship.Name + " " + grade
+		return item, true
+	case strings.HasSuffix(symbol, "_cockpit"):
+		item.Category = CategoryStructure
+		item.Group = "Cockpit"
+		item.Name = "Cockpit"
+		return item, true
+	case symbol == "modularcargobaydoor" || symbol == "modularcargobaydoorfdl":
+		item.Category = CategoryStructure
+		item.Group = "Cargo Hatch"
+		item.Name = "Cargo Hatch"
+		return item, true
+	}
+
+	// Ship kits are named after the ship, as in krait_light_shipkit1_wings2.
+	cosmetic := strings.Contains(symbol, "_shipkit")
+	for _, prefix := range cosmeticPrefixes {
+		cosmetic = cosmetic || strings.HasPrefix(symbol, prefix)
+	}
+	if cosmetic {
+		item.Category = CategoryCosmetic
+		item.Group = "Cosmetic"
+		return item, true
+	}
+	return item, false
+}
+
+// SlotCategory returns the category of the internal slot a module is fitted
+// to, as written in loadouts: CategoryCore for slots such as "PowerPlant" and
+// CategoryOptional for slots such as "Slot04_Size5". Some modules, such as
+// fuel tanks, fit either kind of slot. It returns false for other slots.
+func SlotCategory(slot string) (Category, bool) {
+	switch {
+	case coreSlots[slot]:
+		return CategoryCore, true
+	case strings.HasPrefix(slot, "Slot"), strings.HasPrefix(slot, "Military"):
+		return CategoryOptional, true
+	}
+	return "", false
+}
+
+// decodeInternal decodes symbols like int_shieldgenerator_size5_class3_fast.
+func decodeInternal(item Item) (Item, bool) {
+	var groupParts, variantParts []string
+	for _, part := range strings.Split(item.Symbol, "_")[1:] {
+		switch {
+		case strings.HasPrefix(part, "size") && isNumber(part[len("size"):]):
+			item.Size, _ = strconv.Atoi(part[len("size"):])
+		case strings.HasPrefix(part, "class") && isNumber(part[len("class"):]):
+			class, _ := strconv.Atoi(part[len("class"):])
+			if class >= 1 && class <= len(ratings) {
+				item.Rating = ratings[class-1]
+			}
+		case item.Size >= 0:
+			variantParts = append(variantParts, part)
+		default:
+			groupParts = append(groupParts, part)
+		}
+	}
+
+	group := strings.Join(groupParts, "_")
+	if len(variantParts) > 0 {
+		if name, ok := internalNames[group+"_"+strings.Join(variantParts, "_")]; ok {
+			group = group + "_" + strings.Join(variantParts, "_")
+			item.Group = name
+		}
+	}
+	if item.Group == "" {
+		item.Group = internalNames[group]
+	}
+
+	// Variants such as engine_fast and hyperdrive_overcharge fit the same
+	// core slot as their base group.
+	item.Category = CategoryOptional
+	if len(groupParts) > 0 && coreGroups[groupParts[0]] {
+		item.Category = CategoryCore
+	}
+	if item.Group == "" {
+		return item, false
+	}
+
+	item.Name = item.Group
+	if item.Size >= 0 {
+		item.Name = fmt.Sprintf("%d%s %s", item.Size, item.Rating, item.Group)
+	}
+	return item, true
+}
+
+// decodeHardpoint decodes symbols like hpt_pulselaser_gimbal_medium,
+// hpt_heatsinklauncher_turret_tiny and hpt_shieldbooster_size0_class5.
+func decodeHardpoint(item Item) (Item, bool) {
+	var groupParts []string
+	for _, part := range strings.Split(item.Symbol, "_")[1:] {
+		if mount, ok := mounts[part]; ok {
+			item.Mount = mount
+			continue
+		}
+		if size, ok := hardpointSizes[part]; ok {
+			item.Size = size
+			continue
+		}
+		switch {
+		case strings.HasPrefix(part, "size") && isNumber(part[len("size"):]):
+			item.Size, _ = strconv.Atoi(part[len("size"):])
+		case strings.HasPrefix(part, "class") && isNumber(part[len("class"):]):
+			class, _ := strconv.Atoi(part[len("class"):])
+			if class >= 1 && class <= len(ratings) {
+				item.Rating = ratings[class-1]
+			}
+		case item.Mount == "" && item.Size < 0:
+			groupParts = append(groupParts, part)
+		}
+	}
+
+	group := strings.Join(groupParts, "_")
+	item.Group = hardpointNames[group]
+	item.Category = CategoryHardpoint
+	if item.Size == 0 {
+		item.Category = CategoryUtility
+		// Utilities are all the same size, so the mount isn't worth showing.
+		item.Mount = ""
+	}
+	if item.Group == "" {
+		return item, false
+	}
+
+	switch {
+	case item.Mount != "":
+		item.Name = fmt.Sprintf("%s (%s, %s)", item.Group, item.Mount, hardpointSizeNames[item.Size])
+	case item.Rating != "":
+		item.Name = fmt.Sprintf("%d%s %s", item.Size, item.Rating, item.Group)
+	default:
+		item.Name = item.Group
+	}
+	return item, true
+}
+
+// LookupShip decodes a ship symbol, such as "krait_light".
+func LookupShip(symbol string) (Ship, bool) {
+	symbol = Normalise(symbol)
+	ship, ok := ships[symbol]
+	if !ok {
+		return Ship{Symbol: symbol, Name: symbol}, false
+	}
+	ship.Symbol = symbol
+	return ship, true
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
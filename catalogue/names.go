@@ -0,0 +1,162 @@
+package catalogue
+
+// coreGroups are the internal modules fitted to core slots, by the first
+// part of the symbol after "int_".
+var coreGroups = map[string]bool{
+	"powerplant":               true,
+	"guardianpowerplant":       true,
+	"engine":                   true,
+	"hyperdrive":               true,
+	"lifesupport":              true,
+	"powerdistributor":         true,
+	"guardianpowerdistributor": true,
+	"sensors":                  true,
+	"fueltank":                 true,
+}
+
+// internalNames are the display names of internal modules, by the part of
+// the symbol between "int_" and the size, followed by any variant suffix.
+var internalNames = map[string]string{
+	"powerplant":               "Power Plant",
+	"guardianpowerplant":       "Guardian Hybrid Power Plant",
+	"engine":                   "Thrusters",
+	"engine_fast":              "Enhanced Performance Thrusters",
+	"hyperdrive":               "Frame Shift Drive",
+	"hyperdrive_overcharge":    "Frame Shift Drive (SCO)",
+	"lifesupport":              "Life Support",
+	"powerdistributor":         "Power Distributor",
+	"guardianpowerdistributor": "Guardian Hybrid Power Distributor",
+	"sensors":                  "Sensors",
+	"fueltank":                 "Fuel Tank",
+
+	"cargorack":                            "Cargo Rack",
+	"corrosionproofcargorack":              "Corrosion Resistant Cargo Rack",
+	"shieldgenerator":                      "Shield Generator",
+	"shieldgenerator_fast":                 "Bi-Weave Shield Generator",
+	"shieldgenerator_strong":               "Prismatic Shield Generator",
+	"guardianshieldreinforcement":          "Guardian Shield Reinforcement",
+	"shieldcellbank":                       "Shield Cell Bank",
+	"hullreinforcement":                    "Hull Reinforcement Package",
+	"guardianhullreinforcement":            "Guardian Hull Reinforcement",
+	"modulereinforcement":                  "Module Reinforcement Package",
+	"guardianmodulereinforcement":          "Guardian Module Reinforcement",
+	"metaalloyhullreinforcement":           "Meta Alloy Hull Reinforcement",
+	"fuelscoop":                            "Fuel Scoop",
+	"refinery":                             "Refinery",
+	"repairer":                             "Auto Field-Maintenance Unit",
+	"fsdinterdictor":                       "Frame Shift Drive Interdictor",
+	"guardianfsdbooster":                   "Guardian FSD Booster",
+	"buggybay":                             "Planetary Vehicle Hangar",
+	"fighterbay":                           "Fighter Hangar",
+	"passengercabin":                       "Passenger Cabin",
+	"dockingcomputer_standard":             "Standard Docking Computer",
+	"dockingcomputer_advanced":             "Advanced Docking Computer",
+	"supercruiseassist":                    "Supercruise Assist",
+	"detailedsurfacescanner_tiny":          "Detailed Surface Scanner",
+	"planetapproachsuite":                  "Planetary Approach Suite",
+	"planetapproachsuite_advanced":         "Advanced Planetary Approach Suite",
+	"stellarbodydiscoveryscanner_standard": "Basic Discovery Scanner",
+	"codexscanner":                         "Codex Scanner",
+	"dronecontrol_collection":              "Collector Limpet Controller",
+	"dronecontrol_fueltransfer":            "Fuel Transfer Limpet Controller",
+	"dronecontrol_prospector":              "Prospector Limpet Controller",
+	"dronecontrol_repair":                  "Repair Limpet Controller",
+	"dronecontrol_resourcesiphon":          "Hatch Breaker Limpet Controller",
+	"dronecontrol_decontamination":         "Decontamination Limpet Controller",
+	"dronecontrol_recon":                   "Recon Limpet Controller",
+	"dronecontrol_unkvesselresearch":       "Research Limpet Controller",
+	"multidronecontrol_mining":             "Mining Multi Limpet Controller",
+	"multidronecontrol_operations":         "Operations Multi Limpet Controller",
+	"multidronecontrol_rescue":             "Rescue Multi Limpet Controller",
+	"multidronecontrol_xeno":               "Xeno Multi Limpet Controller",
+	"multidronecontrol_universal":          "Universal Multi Limpet Controller",
+	"expmodulestabiliser":                  "Experimental Weapon Stabiliser",
+}
+
+// hardpointNames are the display names of hardpoints and utilities, by the
+// part of the symbol between "hpt_" and the mount or size.
+var hardpointNames = map[string]string{
+	"pulselaser":              "Pulse Laser",
+	"pulselaserburst":         "Burst Laser",
+	"beamlaser":               "Beam Laser",
+	"multicannon":             "Multi-Cannon",
+	"cannon":                  "Cannon",
+	"slugshot":                "Fragment Cannon",
+	"railgun":                 "Rail Gun",
+	"plasmaaccelerator":       "Plasma Accelerator",
+	"dumbfiremissilerack":     "Missile Rack",
+	"basicmissilerack":        "Seeker Missile Rack",
+	"drunkmissilerack":        "Pack-Hound Missile Rack",
+	"advancedtorppylon":       "Torpedo Pylon",
+	"minelauncher":            "Mine Launcher",
+	"mininglaser":             "Mining Laser",
+	"mining_abrblstr":         "Abrasion Blaster",
+	"mining_seismchrgwarhd":   "Seismic Charge Launcher",
+	"mining_subsurfdispmisle": "Sub-Surface Displacement Missile",
+	"flakmortar":              "Remote Release Flak Launcher",
+	"flechettelauncher":       "Remote Release Flechette Launcher",
+	"guardian_gausscannon":    "Guardian Gauss Cannon",
+	"guardian_plasmalauncher": "Guardian Plasma Charger",
+	"guardian_shardcannon":    "Guardian Shard Cannon",
+	"atdumbfiremissile":       "AX Missile Rack",
+	"atmulticannon":           "AX Multi-Cannon",
+
+	"shieldbooster":            "Shield Booster",
+	"heatsinklauncher":         "Heat Sink Launcher",
+	"chafflauncher":            "Chaff Launcher",
+	"plasmapointdefence":       "Point Defence",
+	"electroniccountermeasure": "Electronic Countermeasure",
+	"cloudscanner":             "Frame Shift Wake Scanner",
+	"cargoscanner":             "Manifest Scanner",
+	"crimescanner":             "Kill Warrant Scanner",
+	"antiunknownshutdown":      "Shutdown Field Neutraliser",
+	"xenoscanner":              "Xeno Scanner",
+	"mrascanner":               "Pulse Wave Analyser",
+}
+
+// ships are the ships known to the catalogue, by symbol.
+var ships = map[string]Ship{
+	"sidewinder":               {Name: "Sidewinder", Pad: "S"},
+	"eagle":                    {Name: "Eagle", Pad: "S"},
+	"hauler":                   {Name: "Hauler", Pad: "S"},
+	"adder":                    {Name: "Adder", Pad: "S"},
+	"empire_eagle":             {Name: "Imperial Eagle", Pad: "S"},
+	"viper":                    {Name: "Viper Mk III", Pad: "S"},
+	"cobramkiii":               {Name: "Cobra Mk III", Pad: "S"},
+	"viper_mkiv":               {Name: "Viper Mk IV", Pad: "S"},
+	"diamondback":              {Name: "Diamondback Scout", Pad: "S"},
+	"cobramkiv":                {Name: "Cobra Mk IV", Pad: "S"},
+	"cobramkv":                 {Name: "Cobra Mk V", Pad: "S"},
+	"type6":                    {Name: "Type-6 Transporter", Pad: "M"},
+	"dolphin":                  {Name: "Dolphin", Pad: "S"},
+	"diamondbackxl":            {Name: "Diamondback Explorer", Pad: "S"},
+	"empire_courier":           {Name: "Imperial Courier", Pad: "S"},
+	"independant_trader":       {Name: "Keelback", Pad: "M"},
+	"asp_scout":                {Name: "Asp Scout", Pad: "M"},
+	"vulture":                  {Name: "Vulture", Pad: "S"},
+	"asp":                      {Name: "Asp Explorer", Pad: "M"},
+	"federation_dropship":      {Name: "Federal Dropship", Pad: "M"},
+	"type7":                    {Name: "Type-7 Transporter", Pad: "L"},
+	"type8":                    {Name: "Type-8 Transporter", Pad: "M"},
+	"typex":                    {Name: "Alliance Chieftain", Pad: "M"},
+	"typex_2":                  {Name: "Alliance Crusader", Pad: "M"},
+	"typex_3":                  {Name: "Alliance Challenger", Pad: "M"},
+	"federation_dropship_mkii": {Name: "Federal Assault Ship", Pad: "M"},
+	"federation_gunship":       {Name: "Federal Gunship", Pad: "M"},
+	"empire_trader":            {Name: "Imperial Clipper", Pad: "L"},
+	"krait_light":              {Name: "Krait Phantom", Pad: "M"},
+	"krait_mkii":               {Name: "Krait Mk II", Pad: "M"},
+	"mandalay":                 {Name: "Mandalay", Pad: "M"},
+	"corsair":                  {Name: "Corsair", Pad: "M"},
+	"orca":                     {Name: "Orca", Pad: "L"},
+	"ferdelance":               {Name: "Fer-de-Lance", Pad: "M"},
+	"mamba":                    {Name: "Mamba", Pad: "M"},
+	"python":                   {Name: "Python", Pad: "M"},
+	"python_nx":                {Name: "Python Mk II", Pad: "M"},
+	"type9":                    {Name: "Type-9 Heavy", Pad: "L"},
+	"type9_military":           {Name: "Type-10 Defender", Pad: "L"},
+	"belugaliner":              {Name: "Beluga Liner", Pad: "L"},
+	"anaconda":                 {Name: "Anaconda", Pad: "L"},
+	"federation_corvette":      {Name: "Federal Corvette", Pad: "L"},
+	"cutter":                   {Name: "Imperial Cutter", Pad: "L"},
+}
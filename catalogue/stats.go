@@ -0,0 +1,65 @@
+package catalogue
+
+import "sync"
+
+// Stats are the base stats of a module, before any engineering.
+type Stats struct {
+	// Mass is in tonnes.
+	Mass float64
+	// PowerDraw is in megawatts. It is zero for power plants, which
+	// generate power instead.
+	PowerDraw float64
+	Integrity float64
+}
+
+var (
+	statsMu sync.RWMutex
+	// stats are the base stats of modules, by symbol, built from the
+	// tables in basestats.go. Register adds more.
+	stats = baseStats()
+)
+
+// Register sets the base stats of the module with the given symbol, adding
+// to or replacing those in the catalogue. It is safe for concurrent use.
+func Register(symbol string, s Stats) {
+	statsMu.Lock()
+	defer statsMu.Unlock()
+	stats[Normalise(symbol)] = s
+}
+
+// lookupStats returns the base stats of a normalised symbol, or nil if they aren't known.
+func lookupStats(symbol string) *Stats {
+	statsMu.RLock()
+	defer statsMu.RUnlock()
+	s, ok := stats[symbol]
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// unpoweredGroups are the kinds of module that never draw power.
+var unpoweredGroups = map[string]bool{
+	"Power Plant":                    true,
+	"Guardian Hybrid Power Plant":    true,
+	"Cargo Rack":                     true,
+	"Corrosion Resistant Cargo Rack": true,
+	"Fuel Tank":                      true,
+	"Hull Reinforcement Package":     true,
+	"Module Reinforcement Package":   true,
+	"Passenger Cabin":                true,
+	"Planetary Approach Suite":       true,
+	"Cockpit":                        true,
+}
+
+// PowerDraw returns the base power draw of the module in megawatts. It
+// returns false if the module draws power but its stats aren't known.
+func (item Item) PowerDraw() (float64, bool) {
+	switch {
+	case item.Category == CategoryArmour, item.Category == CategoryCosmetic, unpoweredGroups[item.Group]:
+		return 0, true
+	case item.Stats != nil:
+		return item.Stats.PowerDraw, true
+	}
+	return 0, false
+}
@@ -0,0 +1,108 @@
+package elite
+
+import (
+	"io/fs"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	// defaultRetries is how many times a companion file such as Status.json
+	// is read before giving up. The game rewrites these files in place, so a
+	// read can catch one half-written.
+	defaultRetries = 5
+	// defaultRetryBackoff is how long to wait between attempts to read a companion file.
+	defaultRetryBackoff = 3 * time.Millisecond
+)
+
+// Client reads data from the files written by the game.
+// The package-level functions, such as GetStatus and GetLoadoutFromPath,
+// use a Client with the default options.
+type Client struct {
+	logPath string
+	fsys    fs.FS
+	retries int
+	backoff time.Duration
+	logger  *log.Logger
+	strict  bool
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithLogPath sets the directory containing the files written by the game.
+// It defaults to the directory found by DiscoverLogPaths.
+func WithLogPath(logPath string) Option {
+	return func(c *Client) {
+		c.logPath = logPath
+	}
+}
+
+// WithFS reads the files written by the game from fsys instead of the log path.
+// The files are expected to be at the root of fsys.
+func WithFS(fsys fs.FS) Option {
+	return func(c *Client) {
+		c.fsys = fsys
+	}
+}
+
+// WithRetry sets how many times companion files such as Status.json are read
+// before giving up, and how long to wait between attempts.
+func WithRetry(count int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = count
+		c.backoff = backoff
+	}
+}
+
+// WithLogger logs problems that would otherwise be silently skipped, such as
+// journal lines that can't be parsed.
+func WithLogger(logger *log.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithStrict makes the Client fail with a *ParseError when it finds a
+// journal line that can't be parsed, rather than skipping it.
+func WithStrict(strict bool) Option {
+	return func(c *Client) {
+		c.strict = strict
+	}
+}
+
+// NewClient creates a Client with the given options.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		retries: defaultRetries,
+		backoff: defaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.fsys == nil {
+		if c.logPath == "" {
+			c.logPath = defaultLogPath()
+		}
+		c.fsys = os.DirFS(c.logPath)
+	}
+	if c.retries < 1 {
+		c.retries = 1
+	}
+	return c
+}
+
+// LogPath returns the directory the Client reads from.
+// It is empty if the Client reads from a file system given by WithFS,
+// unless a path was also given by WithLogPath.
+func (c *Client) LogPath() string {
+	return c.logPath
+}
+
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
@@ -0,0 +1,136 @@
+package elite_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func journalFS(journals map[string][]string) fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for name, lines := range journals {
+		fsys[name] = &fstest.MapFile{Data: []byte(strings.Join(lines, "\n") + "\n")}
+	}
+	return fsys
+}
+
+func TestClientFS(t *testing.T) {
+	fsys := journalFS(historyJournals)
+	fsys["Status.json"] = &fstest.MapFile{Data: []byte(`{"timestamp":"2021-06-01T08:10:00Z", "event":"Status", "Flags":16842765, "Pips":[4,4,4], "FireGroup":0, "GuiFocus":0, "Cargo":0}`)}
+	client := elite.NewClient(elite.WithFS(fsys))
+	if client.LogPath() != "" {
+		fmt.Println("Expected no log path when reading from a file system, got " + client.LogPath())
+		t.FailNow()
+	}
+
+	system, err := client.GetStarSystem()
+	if err != nil {
+		fmt.Println("Couldn't get star system: " + err.Error())
+		t.FailNow()
+	}
+	if system != "Lave" {
+		fmt.Printf("Expected star system Lave, got %s\n", system)
+		t.FailNow()
+	}
+
+	status, err := client.GetStatus()
+	if err != nil {
+		fmt.Println("Couldn't get status: " + err.Error())
+		t.FailNow()
+	}
+	if !status.Flags.Docked {
+		fmt.Println("Expected status to be docked")
+		t.FailNow()
+	}
+}
+
+func TestClientRetry(t *testing.T) {
+	fsys := fstest.MapFS{"Status.json": &fstest.MapFile{Data: []byte(`{"timestamp":`)}}
+	client := elite.NewClient(elite.WithFS(fsys), elite.WithRetry(2, time.Millisecond))
+
+	_, err := client.GetStatus()
+	if err == nil {
+		fmt.Println("Expected an error for a truncated Status.json")
+		t.FailNow()
+	}
+	if !strings.Contains(err.Error(), "after 2 attempts") {
+		fmt.Println("Expected the error to report 2 attempts, got: " + err.Error())
+		t.FailNow()
+	}
+
+	_, err = elite.NewClient(elite.WithFS(fstest.MapFS{})).GetCargo()
+	if errors.Is(err, elite.ErrLogDirMissing) {
+		fmt.Println("Expected a missing file rather than a missing directory")
+		t.FailNow()
+	}
+}
+
+func TestClientLoggerAndStrict(t *testing.T) {
+	fsys := journalFS(historyJournals)
+
+	client := elite.NewClient(elite.WithFS(fsys))
+	files, err := client.ListJournalFiles()
+	if err != nil || len(files) != 3 {
+		fmt.Printf("Expected 3 journal files, got %d (%v)\n", len(files), err)
+		t.FailNow()
+	}
+
+	// Only the oldest file has a malformed line, so strict mode only fails
+	// when the search reaches it.
+	strict := elite.NewClient(elite.WithFS(fsys), elite.WithStrict(true))
+	_, err = strict.GetStatistics()
+	var parseErr *elite.ParseError
+	if !errors.As(err, &parseErr) {
+		fmt.Printf("Expected a *ParseError, got %v\n", err)
+		t.FailNow()
+	}
+	if parseErr.File != "Journal.200110120000.01.log" || parseErr.Line != 5 {
+		fmt.Printf("Unexpected parse error location %s:%d\n", parseErr.File, parseErr.Line)
+		t.FailNow()
+	}
+
+	var logged bytes.Buffer
+	lenient := elite.NewClient(elite.WithFS(fsys), elite.WithLogger(log.New(&logged, "", 0)))
+	if _, err := lenient.GetStatistics(); !errors.Is(err, elite.ErrNotFound) {
+		fmt.Printf("Expected ErrNotFound, got %v\n", err)
+		t.FailNow()
+	}
+	if !strings.Contains(logged.String(), "Journal.200110120000.01.log line 5") {
+		fmt.Println("Expected the malformed line to be logged, got: " + logged.String())
+		t.FailNow()
+	}
+}
+
+func TestClientStrictCommanderState(t *testing.T) {
+	fsys := journalFS(map[string][]string{
+		"Journal.2021-05-20T194000.01.log": {
+			`{ "timestamp":"2021-05-20T19:40:00Z", "event":"LoadGame", "Commander":"Jameson", "Credits":1000000, "Loan":0 }`,
+			`{ "timestamp":"2021-05-20T19:41:00Z", "event":"MarketBuy", "Type":"gold", "Cou`,
+			`{ "timestamp":"2021-05-20T19:42:00Z", "event":"Undocked", "StationName":"Jameson Memorial" }`,
+		},
+	})
+
+	state, err := elite.NewClient(elite.WithFS(fsys)).GetCommanderState()
+	if err != nil || state.Commander != "Jameson" {
+		fmt.Printf("Expected the malformed event to be skipped, got %v\n", err)
+		t.FailNow()
+	}
+
+	_, err = elite.NewClient(elite.WithFS(fsys), elite.WithStrict(true)).GetCommanderState()
+	var parseErr *elite.ParseError
+	if !errors.As(err, &parseErr) {
+		fmt.Printf("Expected a *ParseError, got %v\n", err)
+		t.FailNow()
+	}
+	if parseErr.File != "Journal.2021-05-20T194000.01.log" || parseErr.Line != 2 {
+		fmt.Printf("Unexpected parse error location %s:%d\n", parseErr.File, parseErr.Line)
+		t.FailNow()
+	}
+}
@@ -0,0 +1,298 @@
+package elite
+
+import "strings"
+
+// CommanderState is a view of the commander, their location, ship and
+// inventory, built up by applying journal events in order.
+//
+// A CommanderState is not safe for concurrent use; callers that apply
+// events in one goroutine and read the state in another must synchronise
+// access themselves.
+type CommanderState struct {
+	Commander string
+	FID       string
+	GameMode  string
+	// Credits is the balance written by LoadGame, kept up to date by the
+	// trades, purchases, sales and rewards written since.
+	Credits int64
+	Loan    int64
+
+	StarSystem    string
+	SystemAddress int64
+	StarPos       [3]float64
+	Body          string
+	BodyID        int64
+	BodyType      string
+	Docked        bool
+	StationName   string
+	StationType   string
+	MarketID      int64
+
+	Ship      string
+	ShipID    int64
+	ShipName  string
+	ShipIdent string
+	FuelLevel float64
+	Loadout   *Loadout
+
+	Ranks      Rank
+	Progress   Progress
+	Reputation Reputation
+	Statistics *Statistics
+
+	// Cargo maps commodity names to the quantity carried in the ship's hold.
+	// It is set by Cargo events with an Inventory, and kept up to date by
+	// the events that load or unload cargo.
+	Cargo      map[string]int64
+	CargoCount int64
+	// Materials maps engineering material names to the quantity held.
+	Materials map[string]int64
+}
+
+// NewCommanderState creates an empty CommanderState, ready to have events applied to it.
+func NewCommanderState() *CommanderState {
+	return &CommanderState{
+		Cargo:     map[string]int64{},
+		Materials: map[string]int64{},
+	}
+}
+
+// Apply updates the state with a single journal event.
+// Events that don't affect the state are ignored.
+func (state *CommanderState) Apply(event Event) {
+	switch e := event.(type) {
+	case *Commander:
+		state.Commander = e.Name
+		state.FID = e.FID
+	case *LoadGame:
+		state.Commander = e.Commander
+		state.FID = e.FID
+		state.GameMode = e.GameMode
+		state.Credits = e.Credits
+		state.Loan = e.Loan
+		state.Ship = e.Ship
+		state.ShipID = e.ShipID
+		state.ShipName = e.ShipName
+		state.ShipIdent = e.ShipIdent
+		state.FuelLevel = e.FuelLevel
+	case *Location:
+		state.setSystem(e.StarSystem, e.SystemAddress, e.StarPos)
+		state.setBody(e.Body, e.BodyID, e.BodyType)
+		state.Docked = e.Docked
+		state.StationName = e.StationName
+		state.StationType = e.StationType
+		state.MarketID = e.MarketID
+	case *FSDJump:
+		state.setSystem(e.StarSystem, e.SystemAddress, e.StarPos)
+		state.setBody(e.Body, e.BodyID, e.BodyType)
+		state.FuelLevel = e.FuelLevel
+		state.undock()
+	case *SupercruiseExit:
+		state.setBody(e.Body, e.BodyID, e.BodyType)
+	case *ApproachBody:
+		state.setBody(e.Body, e.BodyID, "Planet")
+	case *Docked:
+		state.StarSystem = e.StarSystem
+		state.SystemAddress = e.SystemAddress
+		state.Docked = true
+		state.StationName = e.StationName
+		state.StationType = e.StationType
+		state.MarketID = e.MarketID
+	case *Undocked:
+		state.undock()
+	case *Loadout:
+		state.Ship = e.Ship
+		state.ShipID = e.ShipID
+		state.ShipName = e.ShipName
+		state.ShipIdent = e.ShipIdent
+		state.Loadout = e
+	case *Cargo:
+		if e.Vessel != "" && e.Vessel != "Ship" {
+			return
+		}
+		state.CargoCount = e.Count
+		if e.Inventory != nil {
+			state.Cargo = map[string]int64{}
+			for _, item := range e.Inventory {
+				state.Cargo[item.Name] += item.Count
+			}
+		}
+	case *Materials:
+		state.Materials = map[string]int64{}
+		for _, list := range [][]Material{e.Raw, e.Manufactured, e.Encoded} {
+			for _, material := range list {
+				state.Materials[material.Name] += material.Count
+			}
+		}
+	case *MaterialCollected:
+		if e.EventName() == "MaterialDiscarded" {
+			state.Materials[e.Name] -= e.Count
+		} else {
+			state.Materials[e.Name] += e.Count
+		}
+	case *Rank:
+		state.Ranks = *e
+	case *Progress:
+		state.Progress = *e
+	case *Reputation:
+		state.Reputation = *e
+	case *Statistics:
+		state.Statistics = e
+	default:
+		state.Credits += creditChange(event)
+		state.applyCargoChange(event)
+	}
+}
+
+// applyCargoChange updates the cargo carried for the events that load or
+// unload cargo. The game follows each of them with a Cargo event giving
+// the new count, but without the Inventory.
+func (state *CommanderState) applyCargoChange(event Event) {
+	switch e := event.(type) {
+	case *MarketBuy:
+		state.addCargo(e.Type, e.Count)
+	case *MarketSell:
+		state.addCargo(e.Type, -e.Count)
+	case *CollectCargo:
+		state.addCargo(e.Type, 1)
+	case *EjectCargo:
+		state.addCargo(e.Type, -e.Count)
+	case *MiningRefined:
+		state.addCargo(e.Type, 1)
+	}
+}
+
+// addCargo adds count of a commodity to the hold, or removes it if count
+// is negative. Commodities are keyed by the lowercase names used in Cargo
+// events, so symbols such as "$platinum_name;" are converted.
+func (state *CommanderState) addCargo(name string, count int64) {
+	name = strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(name, "$"), "_name;"))
+	state.Cargo[name] += count
+	if state.Cargo[name] <= 0 {
+		delete(state.Cargo, name)
+	}
+	state.CargoCount += count
+	if state.CargoCount < 0 {
+		state.CargoCount = 0
+	}
+}
+
+// creditChange returns how much an event changes the commander's credits.
+func creditChange(event Event) int64 {
+	switch e := event.(type) {
+	case *MarketBuy:
+		return -e.TotalCost
+	case *MarketSell:
+		return e.TotalSale
+	case *Refuel:
+		return -e.Cost
+	case *Repair:
+		return -e.Cost
+	case *BuyAmmo:
+		return -e.Cost
+	case *BuyDrones:
+		return -e.TotalCost
+	case *SellDrones:
+		return e.TotalSale
+	case *PayFines:
+		return -e.Amount
+	case *RedeemVoucher:
+		return e.Amount
+	case *ShipyardBuy:
+		return e.SellPrice - e.ShipPrice
+	case *ShipyardSell:
+		return e.ShipPrice
+	case *ShipyardTransfer:
+		return -e.TransferPrice
+	case *ModuleBuy:
+		return e.SellPrice - e.BuyPrice
+	case *ModuleSell:
+		return e.SellPrice
+	case *ModuleSellRemote:
+		return e.SellPrice
+	case *ModuleStore:
+		return -e.Cost
+	case *ModuleRetrieve:
+		return -e.Cost
+	case *FetchRemoteModule:
+		return -e.TransferCost
+	case *MissionCompleted:
+		return e.Reward - e.Donated
+	case *SellExplorationData:
+		return e.TotalEarnings
+	}
+	return 0
+}
+
+func (state *CommanderState) setSystem(name string, address int64, pos [3]float64) {
+	state.StarSystem = name
+	state.SystemAddress = address
+	state.StarPos = pos
+}
+
+func (state *CommanderState) setBody(name string, id int64, bodyType string) {
+	state.Body = name
+	state.BodyID = id
+	state.BodyType = bodyType
+}
+
+func (state *CommanderState) undock() {
+	state.Docked = false
+	state.StationName = ""
+	state.StationType = ""
+	state.MarketID = 0
+}
+
+// GetCommanderState builds the commander's current state from the journal files.
+// It will read them from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetCommanderStateFromPath.
+func GetCommanderState() (*CommanderState, error) {
+	return GetCommanderStateFromPath(defaultLogPath())
+}
+
+// GetCommanderStateFromPath builds the commander's current state from the journal files at the specified path.
+// Since the game writes a complete snapshot of the commander when it loads, only the
+// journal files since the most recent LoadGame event are read. The returned state can be
+// kept up to date by applying the events from a Tailer.
+func GetCommanderStateFromPath(logPath string) (*CommanderState, error) {
+	return NewClient(WithLogPath(logPath)).GetCommanderState()
+}
+
+// GetCommanderState builds the commander's current state from the journal files,
+// starting from the most recent LoadGame event.
+func (c *Client) GetCommanderState() (*CommanderState, error) {
+	files, err := c.ListJournalFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	for i := len(files) - 1; i >= 0; i-- {
+		found := false
+		err := c.forEachEvent(files[i].Name, func(event Event) {
+			if event.EventName() == "LoadGame" {
+				found = true
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			start = i
+			break
+		}
+	}
+
+	state := NewCommanderState()
+	for _, file := range files[start:] {
+		if err := c.forEachEvent(file.Name, state.Apply); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
@@ -0,0 +1,126 @@
+package elite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+func applyLines(t *testing.T, state interface{ Apply(elite.Event) }, lines ...string) {
+	for _, line := range lines {
+		event, err := elite.ParseEvent([]byte(line))
+		if err != nil {
+			fmt.Println("Couldn't parse event: " + err.Error())
+			t.FailNow()
+		}
+		state.Apply(event)
+	}
+}
+
+func TestGetCommanderStateFromPath(t *testing.T) {
+	state, err := elite.GetCommanderStateFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get commander state: " + err.Error())
+		t.FailNow()
+	}
+
+	if state.StarSystem != "Sol" {
+		fmt.Println("Incorrect star system: Expecting Sol, got " + state.StarSystem)
+		t.FailNow()
+	}
+	if state.ShipName != "dora winifred" || state.Loadout == nil || state.Loadout.ShipID != 15 {
+		fmt.Println("Incorrect ship in commander state")
+		t.FailNow()
+	}
+	if state.Statistics == nil || state.Statistics.BankAccount.CurrentWealth != 951994467 {
+		fmt.Println("Incorrect statistics in commander state")
+		t.FailNow()
+	}
+}
+
+func TestCommanderStateApply(t *testing.T) {
+	state := elite.NewCommanderState()
+	applyLines(t, state,
+		`{ "timestamp":"2021-05-20T19:40:00Z", "event":"LoadGame", "FID":"F1234", "Commander":"Jameson", "Ship":"krait_light", "ShipID":15, "ShipName":"dora winifred", "ShipIdent":"cp1-dw", "Credits":1000000, "Loan":0, "GameMode":"Open" }`,
+		`{ "timestamp":"2021-05-20T19:40:01Z", "event":"Rank", "Combat":5, "Trade":8, "Explore":7 }`,
+		`{ "timestamp":"2021-05-20T19:40:01Z", "event":"Materials", "Raw":[ { "Name":"iron", "Count":10 } ], "Manufactured":[], "Encoded":[] }`,
+		`{ "timestamp":"2021-05-20T19:40:02Z", "event":"Location", "Docked":true, "StationName":"Jameson Memorial", "StationType":"Orbis", "MarketID":128666762, "StarSystem":"Shinrarta Dezhra", "SystemAddress":3932277478106, "StarPos":[55.71875,17.59375,27.15625] }`,
+		`{ "timestamp":"2021-05-20T19:40:03Z", "event":"Cargo", "Vessel":"Ship", "Count":4, "Inventory":[ { "Name":"gold", "Count":3, "Stolen":0 }, { "Name":"gold", "Count":1, "Stolen":1 } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"Undocked", "StationName":"Jameson Memorial", "StationType":"Orbis", "MarketID":128666762 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"FSDJump", "StarSystem":"LHS 3447", "SystemAddress":2, "StarPos":[-43.1875,-5.28125,56.15625], "Body":"LHS 3447", "BodyID":0, "BodyType":"Star", "FuelLevel":30.5 }`,
+		`{ "timestamp":"2021-05-20T19:43:00Z", "event":"MaterialCollected", "Category":"Raw", "Name":"iron", "Count":3 }`,
+	)
+
+	if state.Commander != "Jameson" || state.Credits != 1000000 || state.Ranks.Trade != 8 {
+		fmt.Println("Incorrect commander details")
+		t.FailNow()
+	}
+	if state.StarSystem != "LHS 3447" || state.Body != "LHS 3447" || state.Docked || state.StationName != "" {
+		fmt.Println("Incorrect location after jump")
+		t.FailNow()
+	}
+	if state.FuelLevel != 30.5 {
+		fmt.Printf("Incorrect fuel level: Expecting 30.5, got %f\n", state.FuelLevel)
+		t.FailNow()
+	}
+	if state.Cargo["gold"] != 4 || state.CargoCount != 4 {
+		fmt.Printf("Incorrect cargo: Expecting 4 gold, got %d\n", state.Cargo["gold"])
+		t.FailNow()
+	}
+	if state.Materials["iron"] != 13 {
+		fmt.Printf("Incorrect materials: Expecting 13 iron, got %d\n", state.Materials["iron"])
+		t.FailNow()
+	}
+
+	applyLines(t, state,
+		`{ "timestamp":"2021-05-20T19:50:00Z", "event":"Docked", "StationName":"Bluford Orbital", "StationType":"Coriolis", "StarSystem":"LHS 3447", "SystemAddress":2, "MarketID":3229009408 }`,
+	)
+	if !state.Docked || state.StationName != "Bluford Orbital" || state.MarketID != 3229009408 {
+		fmt.Println("Incorrect station after docking")
+		t.FailNow()
+	}
+}
+
+func TestCommanderStateCredits(t *testing.T) {
+	state := elite.NewCommanderState()
+	applyLines(t, state,
+		`{ "timestamp":"2021-05-20T19:40:00Z", "event":"LoadGame", "Commander":"Jameson", "Credits":1000000, "Loan":0 }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"MarketBuy", "MarketID":128666762, "Type":"gold", "Count":10, "BuyPrice":9000, "TotalCost":90000 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"MarketSell", "MarketID":128666762, "Type":"gold", "Count":10, "SellPrice":10000, "TotalSale":100000, "AvgPricePaid":9000 }`,
+		`{ "timestamp":"2021-05-20T19:43:00Z", "event":"ModuleBuy", "MarketID":128666762, "Slot":"Slot01_Size5", "BuyItem":"int_cargorack_size5_class1", "BuyPrice":97000, "Ship":"krait_light", "ShipID":15, "SellItem":"int_cargorack_size4_class1", "SellPrice":30000 }`,
+		`{ "timestamp":"2021-05-20T19:44:00Z", "event":"RedeemVoucher", "Type":"bounty", "Amount":250000, "Factions":[ { "Faction":"Pilots' Federation", "Amount":250000 } ] }`,
+		`{ "timestamp":"2021-05-20T19:45:00Z", "event":"MissionCompleted", "MissionID":1, "Name":"Mission_Delivery", "Faction":"Pilots' Federation", "Reward":120000, "Donated":20000 }`,
+		`{ "timestamp":"2021-05-20T19:46:00Z", "event":"RefuelAll", "Cost":500, "Amount":10.0 }`,
+	)
+
+	// 1000000 - 90000 + 100000 - 67000 + 250000 + 100000 - 500
+	if state.Credits != 1292500 {
+		fmt.Printf("Incorrect credits: Expecting 1292500, got %d\n", state.Credits)
+		t.FailNow()
+	}
+}
+
+func TestCommanderStateCargo(t *testing.T) {
+	state := elite.NewCommanderState()
+	applyLines(t, state,
+		`{ "timestamp":"2021-05-20T19:40:00Z", "event":"Cargo", "Vessel":"Ship", "Count":4, "Inventory":[ { "Name":"gold", "Count":4, "Stolen":0 } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"MarketBuy", "MarketID":128666762, "Type":"silver", "Count":10, "BuyPrice":4000, "TotalCost":40000 }`,
+		`{ "timestamp":"2021-05-20T19:41:01Z", "event":"Cargo", "Vessel":"Ship", "Count":14 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"MarketSell", "MarketID":128666762, "Type":"gold", "Count":4, "SellPrice":10000, "TotalSale":40000, "AvgPricePaid":9000 }`,
+		`{ "timestamp":"2021-05-20T19:42:01Z", "event":"Cargo", "Vessel":"Ship", "Count":10 }`,
+		`{ "timestamp":"2021-05-20T19:50:00Z", "event":"MiningRefined", "Type":"$platinum_name;", "Type_Localised":"Platinum" }`,
+		`{ "timestamp":"2021-05-20T19:51:00Z", "event":"CollectCargo", "Type":"platinum", "Stolen":false }`,
+		`{ "timestamp":"2021-05-20T19:52:00Z", "event":"EjectCargo", "Type":"silver", "Count":3, "Abandoned":true }`,
+		`{ "timestamp":"2021-05-20T19:52:01Z", "event":"Cargo", "Vessel":"Ship", "Count":9 }`,
+	)
+
+	if _, ok := state.Cargo["gold"]; ok || state.Cargo["silver"] != 7 || state.Cargo["platinum"] != 2 {
+		fmt.Printf("Incorrect cargo: %v\n", state.Cargo)
+		t.FailNow()
+	}
+	if state.CargoCount != 9 {
+		fmt.Printf("Incorrect cargo count: Expecting 9, got %d\n", state.CargoCount)
+		t.FailNow()
+	}
+}
@@ -0,0 +1,142 @@
+package elite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// companionFiles are the JSON files the game writes next to the journal,
+// each containing a single event that is replaced whenever it changes.
+var companionFiles = []string{
+	"Cargo.json",
+	"Market.json",
+	"Outfitting.json",
+	"Shipyard.json",
+	"NavRoute.json",
+	"ModulesInfo.json",
+	"Backpack.json",
+	"ShipLocker.json",
+	"FCMaterials.json",
+}
+
+// readCompanionFile reads and parses the named companion file.
+// Both reading and parsing are retried, since a failure usually means that
+// the game was in the middle of writing the file.
+func (c *Client) readCompanionFile(name string, parse func([]byte) error) error {
+	var err error
+	for attempt := 1; attempt <= c.retries; attempt++ {
+		var content []byte
+		if content, err = fs.ReadFile(c.fsys, name); err == nil {
+			if err = parse(content); err == nil {
+				return nil
+			}
+		}
+		if attempt < c.retries {
+			c.logf("Couldn't read %s, retrying: %v", name, err)
+			time.Sleep(c.backoff)
+		}
+	}
+
+	if errors.Is(err, fs.ErrNotExist) {
+		if _, dirErr := fs.Stat(c.fsys, "."); errors.Is(dirErr, fs.ErrNotExist) {
+			return fmt.Errorf("%w: %s", ErrLogDirMissing, c.logPath)
+		}
+	}
+	return fmt.Errorf("Couldn't read %s after %d attempts: %w", name, c.retries, err)
+}
+
+// CompanionWatcher polls the companion files that the game writes next to
+// the journal, such as Market.json and NavRoute.json, and delivers their
+// contents as events whenever they are rewritten. For example, a *Market
+// event is delivered each time the player opens the commodity market.
+//
+// The current contents of each file are delivered when watching starts.
+type CompanionWatcher struct {
+	// PollInterval is how often the files are checked for changes.
+	PollInterval time.Duration
+
+	watches []*fileWatch
+	err     error
+}
+
+// NewCompanionWatcher creates a CompanionWatcher for the named files at the specified log path.
+// If no file names are given, every companion file known to the package is watched.
+func NewCompanionWatcher(logPath string, names ...string) *CompanionWatcher {
+	return NewClient(WithLogPath(logPath)).NewCompanionWatcher(names...)
+}
+
+// NewCompanionWatcher creates a CompanionWatcher for the Client's named companion files.
+// If no file names are given, every companion file known to the package is watched.
+func (c *Client) NewCompanionWatcher(names ...string) *CompanionWatcher {
+	if len(names) == 0 {
+		names = companionFiles
+	}
+
+	watcher := &CompanionWatcher{PollInterval: DefaultPollInterval}
+	for _, name := range names {
+		watcher.watches = append(watcher.watches, &fileWatch{fsys: c.fsys, name: name})
+	}
+	return watcher
+}
+
+// Events starts watching the files and returns a channel of events.
+// The channel is closed when the context is cancelled or an error occurs,
+// after which Err reports the error, if any.
+// Events should only be called once per CompanionWatcher.
+func (w *CompanionWatcher) Events(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+	go w.run(ctx, events)
+	return events
+}
+
+// Err returns the error that stopped the CompanionWatcher, if any.
+// It is only valid once the channel returned by Events has been closed.
+func (w *CompanionWatcher) Err() error {
+	return w.err
+}
+
+func (w *CompanionWatcher) run(ctx context.Context, events chan<- Event) {
+	defer close(events)
+
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, watch := range w.watches {
+			content, err := watch.poll()
+			if err != nil {
+				w.err = err
+				return
+			}
+			if content == nil {
+				continue
+			}
+
+			event, err := ParseEvent(content)
+			if err != nil {
+				// Most likely only partially written; try again next poll.
+				continue
+			}
+			watch.accept()
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
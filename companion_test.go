@@ -0,0 +1,132 @@
+package elite_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestGetCargoFromPath(t *testing.T) {
+	cargo, err := elite.GetCargoFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get cargo: " + err.Error())
+		t.FailNow()
+	}
+
+	if cargo.Count != 12 || len(cargo.Inventory) != 2 || cargo.Inventory[0].Name != "gold" {
+		fmt.Println("Parsed cargo was incorrect")
+		t.FailNow()
+	}
+}
+
+func TestGetMarketFromPath(t *testing.T) {
+	market, err := elite.GetMarketFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get market: " + err.Error())
+		t.FailNow()
+	}
+
+	if market.StationName != "Abraham Lincoln" || len(market.Items) != 3 {
+		fmt.Println("Parsed market was incorrect")
+		t.FailNow()
+	}
+	if market.Items[1].NameLocalised != "Gold" || market.Items[1].SellPrice != 49831 {
+		fmt.Println("Parsed market items were incorrect")
+		t.FailNow()
+	}
+}
+
+func TestGetNavRouteFromPath(t *testing.T) {
+	route, err := elite.GetNavRouteFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get route: " + err.Error())
+		t.FailNow()
+	}
+
+	if len(route.Route) != 2 || route.Route[1].StarSystem != "Alpha Centauri" {
+		fmt.Println("Parsed route was incorrect")
+		t.FailNow()
+	}
+}
+
+func TestGetShipyardFromPathMissing(t *testing.T) {
+	if _, err := elite.GetShipyardFromPath(testLogPath); err == nil {
+		fmt.Println("Expected an error for a missing Shipyard.json")
+		t.FailNow()
+	}
+}
+
+func TestCompanionWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	watcher := elite.NewCompanionWatcher(dir, "Market.json")
+	watcher.PollInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := watcher.Events(ctx)
+
+	content, err := ioutil.ReadFile(filepath.Join(testLogPath, "Market.json"))
+	if err != nil {
+		fmt.Println("Couldn't read market: " + err.Error())
+		t.FailNow()
+	}
+
+	// A partial write must be skipped until the file is complete.
+	if err := ioutil.WriteFile(filepath.Join(dir, "Market.json"), content[:40], 0644); err != nil {
+		fmt.Println("Couldn't write market: " + err.Error())
+		t.FailNow()
+	}
+	time.Sleep(50 * time.Millisecond)
+	if err := ioutil.WriteFile(filepath.Join(dir, "Market.json"), content, 0644); err != nil {
+		fmt.Println("Couldn't write market: " + err.Error())
+		t.FailNow()
+	}
+
+	event := expectEvent(t, events, "Market")
+	if market, ok := event.(*elite.Market); !ok || len(market.Items) != 3 {
+		fmt.Println("Market event was not decoded with its items")
+		t.FailNow()
+	}
+}
+
+func TestGetOnFootInventoryFromPath(t *testing.T) {
+	inventory, err := elite.GetOnFootInventoryFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get on-foot inventory: " + err.Error())
+		t.FailNow()
+	}
+
+	if inventory.Backpack.Count("healthpack") != 2 || inventory.ShipLocker.Count("healthpack") != 10 {
+		fmt.Println("Incorrect medkit counts in backpack and locker")
+		t.FailNow()
+	}
+	if count := inventory.Count("Medkit"); count != 12 {
+		fmt.Printf("Incorrect total medkits: Expecting 12, got %d\n", count)
+		t.FailNow()
+	}
+	if count := inventory.Count("GRAPHENE"); count != 15 {
+		fmt.Printf("Incorrect total graphene: Expecting 15, got %d\n", count)
+		t.FailNow()
+	}
+	if inventory.ShipLocker.Items[0].MissionID != 773212345 {
+		fmt.Println("Mission ID was not parsed")
+		t.FailNow()
+	}
+
+	totals := inventory.Totals()
+	if totals["largecapacitypowerregulator"] != 3 || totals["surveilleancelogs"] != 1 || totals["energycell"] != 4 {
+		fmt.Println("Incorrect inventory totals")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,186 @@
+package elite
+
+import (
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// LogPathEnv is the environment variable that, if set, overrides the log
+// path found by DiscoverLogPaths.
+const LogPathEnv = "ELITE_JOURNAL_DIR"
+
+// steamAppID is the Steam app ID of Elite Dangerous, which names its Proton prefix.
+const steamAppID = "359320"
+
+// savedGamesSubdir is where the game writes its files within the Saved Games folder.
+var savedGamesSubdir = filepath.Join("Frontier Developments", "Elite Dangerous")
+
+// Sources of log path candidates.
+const (
+	LogPathSourceEnv        = "env"
+	LogPathSourceSavedGames = "savedgames"
+	LogPathSourceProton     = "proton"
+	LogPathSourceWine       = "wine"
+)
+
+// LogPathCandidate is a directory that may contain the game's journal files.
+type LogPathCandidate struct {
+	Path string
+	// Source describes where the candidate was found, such as LogPathSourceProton.
+	Source string
+	// LastJournal is the modification time of the most recently written
+	// journal file in the directory, or the zero time if there are none.
+	LastJournal time.Time
+}
+
+// DiscoverLogPaths returns the directories that may contain the game's
+// journal files, looking in:
+//
+//   - the directory named by the ELITE_JOURNAL_DIR environment variable
+//   - the Windows Saved Games folder
+//   - Steam Proton prefixes, including those in additional Steam libraries
+//   - Wine prefixes, including WINEPREFIX, ~/.wine and Lutris prefixes
+//
+// Only directories that exist are returned. The environment variable always
+// comes first; the rest are ordered by their most recently written journal file.
+func DiscoverLogPaths() []LogPathCandidate {
+	var candidates []LogPathCandidate
+	seen := map[string]bool{}
+	add := func(path, source string) {
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			return
+		}
+		key := path
+		if resolved, err := filepath.EvalSymlinks(path); err == nil {
+			key = resolved
+		}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		candidates = append(candidates, LogPathCandidate{Path: path, Source: source, LastJournal: lastJournalTime(path)})
+	}
+
+	if path := os.Getenv(LogPathEnv); path != "" {
+		add(path, LogPathSourceEnv)
+	}
+	overridden := len(candidates)
+
+	if savedGames, err := savedGamesDir(); err == nil {
+		add(filepath.Join(savedGames, savedGamesSubdir), LogPathSourceSavedGames)
+	}
+
+	home := homeDir()
+	if home != "" {
+		add(filepath.Join(home, "Saved Games", savedGamesSubdir), LogPathSourceSavedGames)
+		for _, prefix := range protonPrefixes(home) {
+			add(filepath.Join(prefix, "drive_c", "users", "steamuser", "Saved Games", savedGamesSubdir), LogPathSourceProton)
+		}
+	}
+	for _, prefix := range winePrefixes(home) {
+		matches, _ := filepath.Glob(filepath.Join(prefix, "drive_c", "users", "*", "Saved Games", savedGamesSubdir))
+		for _, match := range matches {
+			add(match, LogPathSourceWine)
+		}
+	}
+
+	rest := candidates[overridden:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].LastJournal.After(rest[j].LastJournal)
+	})
+	return candidates
+}
+
+// homeDir returns the current user's home directory, or an empty string if it can't be found.
+func homeDir() string {
+	if home, err := os.UserHomeDir(); err == nil {
+		return home
+	}
+	if currUser, err := user.Current(); err == nil {
+		return currUser.HomeDir
+	}
+	return ""
+}
+
+// lastJournalTime returns the modification time of the most recently written journal file in dir.
+func lastJournalTime(dir string) time.Time {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return time.Time{}
+	}
+
+	var last time.Time
+	for _, file := range files {
+		if _, ok := ParseJournalFileName(file.Name()); ok && file.ModTime().After(last) {
+			last = file.ModTime()
+		}
+	}
+	return last
+}
+
+var steamLibraryPathPattern = regexp.MustCompile(`"path"\s+"([^"]+)"`)
+
+// protonPrefixes returns the Proton prefixes for the game in every Steam library that can be found.
+func protonPrefixes(home string) []string {
+	steamRoots := []string{
+		filepath.Join(home, ".steam", "steam"),
+		filepath.Join(home, ".steam", "root"),
+		filepath.Join(home, ".local", "share", "Steam"),
+		filepath.Join(home, ".var", "app", "com.valvesoftware.Steam", ".local", "share", "Steam"),
+		filepath.Join(home, "snap", "steam", "common", ".local", "share", "Steam"),
+	}
+
+	libraries := append([]string{}, steamRoots...)
+	for _, root := range steamRoots {
+		content, err := ioutil.ReadFile(filepath.Join(root, "steamapps", "libraryfolders.vdf"))
+		if err != nil {
+			continue
+		}
+		for _, match := range steamLibraryPathPattern.FindAllStringSubmatch(string(content), -1) {
+			libraries = append(libraries, match[1])
+		}
+	}
+
+	var prefixes []string
+	for _, library := range libraries {
+		prefixes = append(prefixes, filepath.Join(library, "steamapps", "compatdata", steamAppID, "pfx"))
+	}
+	return prefixes
+}
+
+var lutrisPrefixPattern = regexp.MustCompile(`(?m)^\s*prefix:\s*(.+?)\s*$`)
+
+// winePrefixes returns the Wine prefixes that may contain the game.
+func winePrefixes(home string) []string {
+	var prefixes []string
+	if prefix := os.Getenv("WINEPREFIX"); prefix != "" {
+		prefixes = append(prefixes, prefix)
+	}
+	if home == "" {
+		return prefixes
+	}
+
+	prefixes = append(prefixes, filepath.Join(home, ".wine"))
+
+	// Lutris installs games into ~/Games/<game> by default, but the prefix
+	// can be moved, so also check the prefix in each Lutris game config.
+	games, _ := filepath.Glob(filepath.Join(home, "Games", "*"))
+	prefixes = append(prefixes, games...)
+	configs, _ := filepath.Glob(filepath.Join(home, ".config", "lutris", "games", "*.yml"))
+	for _, config := range configs {
+		content, err := ioutil.ReadFile(config)
+		if err != nil {
+			continue
+		}
+		for _, match := range lutrisPrefixPattern.FindAllStringSubmatch(string(content), -1) {
+			prefixes = append(prefixes, match[1])
+		}
+	}
+	return prefixes
+}
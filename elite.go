@@ -3,9 +3,8 @@
 package elite
 
 import (
-	"os/user"
 	"path/filepath"
-	"regexp"
+	"sync"
 )
 
 // JournalEntry is a minimal entry in the Journal file.
@@ -16,12 +15,21 @@ type JournalEntry struct {
 	Event     string `json:"event"`
 }
 
-var defaultLogPath string
-var journalFilePattern *regexp.Regexp
+var (
+	defaultLogPathOnce sync.Once
+	defaultLogPathDir  string
+)
 
-func init() {
-	currUser, _ := user.Current()
-	homeDir := currUser.HomeDir
-	defaultLogPath = filepath.FromSlash(homeDir + "/Saved Games/Frontier Developments/Elite Dangerous")
-	journalFilePattern = regexp.MustCompile(`^Journal\.\d{4}\-\d{2}\-\d{2}T\d{6}\.\d{2}\.log$`)
+// defaultLogPath returns the directory used when no log path is given.
+// It is found by DiscoverLogPaths the first time it is needed, so that
+// programs that never read from the default directory don't search for it.
+func defaultLogPath() string {
+	defaultLogPathOnce.Do(func() {
+		if candidates := DiscoverLogPaths(); len(candidates) > 0 {
+			defaultLogPathDir = candidates[0].Path
+		} else {
+			defaultLogPathDir = filepath.Join(homeDir(), "Saved Games", savedGamesSubdir)
+		}
+	})
+	return defaultLogPathDir
 }
@@ -1,10 +1,20 @@
 package elite_test
 
 import (
+	"context"
 	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/BenJuan26/elite"
+	"github.com/BenJuan26/elite/catalogue"
+	"github.com/BenJuan26/elite/flags"
+	"github.com/BenJuan26/elite/flags2"
+	"github.com/BenJuan26/elite/loadout"
 )
 
 var testLogPath = "./test"
@@ -57,6 +67,123 @@ func TestGetStatusFromBytes(t *testing.T) {
 	}
 }
 
+func TestGetStatusFromBytesOdyssey(t *testing.T) {
+	status, err := elite.GetStatusFromBytes([]byte(`{ "timestamp":"2021-05-20T19:40:34Z", "event":"Status", "Flags":0, "Flags2":65609, "Oxygen":1.000000, "Health":0.950000, "Temperature":293.500000, "SelectedWeapon":"$humanoid_fists_name;", "SelectedWeapon_Localised":"Unarmed", "Gravity":0.166092, "LegalState":"Clean", "Latitude":0.000000, "Longitude":0.000000, "Heading":0, "BodyName":"Jameson Memorial", "Balance":3210654, "Destination":{ "System":5031721931482, "Body":0, "Name":"Shinrarta Dezhra" } }`))
+	if err != nil {
+		fmt.Println("Couldn't get status: " + err.Error())
+		t.FailNow()
+	}
+
+	if !status.Flags2.OnFoot ||
+		!status.Flags2.OnFootInStation ||
+		!status.Flags2.LowOxygen ||
+		!status.Flags2.BreathableAtmosphere {
+		fmt.Println("Parsed flags2 were incorrect")
+		t.FailNow()
+	}
+
+	if status.Flags2.InTaxi ||
+		status.Flags2.OnFootOnPlanet ||
+		status.Flags2.LowHealth ||
+		status.Flags2.GlideMode {
+		fmt.Println("Parsed flags2 were incorrect")
+		t.FailNow()
+	}
+
+	if status.Health != 0.95 || status.Temperature != 293.5 || status.SelectedWeapon != "$humanoid_fists_name;" ||
+		status.LegalState != "Clean" || status.BodyName != "Jameson Memorial" || status.Balance != 3210654 {
+		fmt.Println("Parsed on-foot fields were incorrect")
+		t.FailNow()
+	}
+
+	if status.Destination == nil || status.Destination.Name != "Shinrarta Dezhra" {
+		fmt.Println("Parsed destination was incorrect")
+		t.FailNow()
+	}
+}
+
+func TestStatusGuiFocusAndPips(t *testing.T) {
+	status, err := elite.GetStatusFromBytes([]byte(`{"timestamp":"2017-12-07T10:31:37Z", "event":"Status", "Flags":16842765, "Pips":[3,8,1], "FireGroup":0, "GuiFocus":6}`))
+	if err != nil {
+		fmt.Println("Couldn't get status: " + err.Error())
+		t.FailNow()
+	}
+
+	if status.GuiFocus != flags.GuiFocusGalaxyMap || status.GuiFocus.String() != "GalaxyMap" || !status.GuiFocus.IsMapOpen() {
+		fmt.Printf("Unexpected GuiFocus %v\n", status.GuiFocus)
+		t.FailNow()
+	}
+	if flags.GuiFocus(42).String() != "GuiFocus(42)" || flags.GuiFocusRolePanel.IsMapOpen() || !flags.GuiFocusRolePanel.IsPanelFocused() {
+		fmt.Println("GuiFocus helpers were incorrect")
+		t.FailNow()
+	}
+
+	if status.Pips.Sys() != 1.5 || status.Pips.Eng() != 4 || status.Pips.Wep() != 0.5 {
+		fmt.Printf("Unexpected pips %.1f/%.1f/%.1f\n", status.Pips.Sys(), status.Pips.Eng(), status.Pips.Wep())
+		t.FailNow()
+	}
+
+	if !status.Flags.MapOpen || !status.Flags.GalaxyMapOpen || status.Flags.SystemMapOpen || status.Flags.PanelFocused ||
+		!status.Flags.FullEnginesPips || status.Flags.FullSystemsPips || status.Flags.FullWeaponsPips {
+		fmt.Println("Derived flags were incorrect")
+		t.FailNow()
+	}
+}
+
+func TestFlagsBitset(t *testing.T) {
+	f := flags.Flags(16842765)
+	if f.String() != "Docked|LandingGearDown|ShieldsUp|FSDMassLocked|InMainShip" {
+		fmt.Println("Unexpected flags string: " + f.String())
+		t.FailNow()
+	}
+
+	parsed, err := flags.ParseFlags(" Docked | LandingGearDown|ShieldsUp|FSDMassLocked|InMainShip")
+	if err != nil || parsed != f {
+		fmt.Printf("Couldn't parse flags: got %v (%v)\n", parsed, err)
+		t.FailNow()
+	}
+	if _, err := flags.ParseFlags("Docked|Warping"); err == nil {
+		fmt.Println("Expected an error for an unknown flag")
+		t.FailNow()
+	}
+
+	f = f.Clear(flags.Docked | flags.LandingGearDown).Set(flags.Supercruise)
+	if f.Has(flags.Docked) || !f.Has(flags.Supercruise|flags.ShieldsUp) || len(f.List()) != 4 {
+		fmt.Println("Unexpected flags after Set and Clear: " + f.String())
+		t.FailNow()
+	}
+	if flags.Flags(0).String() != "" {
+		fmt.Println("Expected no flags to render as an empty string")
+		t.FailNow()
+	}
+
+	// The flag constants still work with the uint32 RawFlags of a Status.
+	status := elite.Status{RawFlags: 16842765, RawFlags2: flags2.OnFoot | flags2.OnFootInStation}
+	if status.RawFlags&flags.Docked == 0 || flags2.Flags(status.RawFlags2).String() != "OnFoot|OnFootInStation" {
+		fmt.Println("Flag constants don't match the raw Status flags")
+		t.FailNow()
+	}
+}
+
+func TestCollapseFlags(t *testing.T) {
+	for _, raw := range []uint32{0, 553713677, 0xFFFFFFFF} {
+		status := &elite.Status{RawFlags: raw, RawFlags2: 0xFFFFF, GuiFocus: flags.GuiFocusGalaxyMap}
+		status.ExpandFlags()
+		status.RawFlags, status.RawFlags2 = 0, 0
+		status.CollapseFlags()
+		if status.RawFlags != raw || status.RawFlags2 != 0xFFFFF {
+			fmt.Printf("Expected flags %#x/%#x after collapsing, got %#x/%#x\n", raw, 0xFFFFF, status.RawFlags, status.RawFlags2)
+			t.FailNow()
+		}
+	}
+
+	flagsOnly := elite.StatusFlags{Docked: true, InMainShip: true, MapOpen: true}
+	if flags.Flags(flagsOnly.Raw()).String() != "Docked|InMainShip" {
+		fmt.Println("Derived flags should not be collapsed into the bitmask")
+		t.FailNow()
+	}
+}
+
 func TestGetStatusFromPath(t *testing.T) {
 	status, err := elite.GetStatusFromPath(testLogPath)
 	if err != nil {
@@ -113,6 +240,181 @@ func TestGetStatus(t *testing.T) {
 	}
 }
 
+func TestStatusWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	statusPath := filepath.Join(dir, "Status.json")
+	writeStatus := func(content string) {
+		if err := ioutil.WriteFile(statusPath, []byte(content), 0644); err != nil {
+			fmt.Println("Couldn't write status: " + err.Error())
+			t.FailNow()
+		}
+	}
+	nextChange := func(changes <-chan elite.StatusChange) elite.StatusChange {
+		select {
+		case change := <-changes:
+			return change
+		case <-time.After(2 * time.Second):
+			fmt.Println("Timed out waiting for a status change")
+			t.FailNow()
+		}
+		return elite.StatusChange{}
+	}
+
+	writeStatus(`{"timestamp":"2017-12-07T10:31:37Z", "event":"Status", "Flags":16842765, "Pips":[4,8,0], "FireGroup":0, "GuiFocus":0, "Fuel":{ "FuelMain":32.0, "FuelReservoir":0.63 }, "Cargo":0.0}`)
+
+	watcher := elite.NewStatusWatcher(dir)
+	watcher.PollInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := watcher.Changes(ctx)
+
+	change := nextChange(changes)
+	if change.Previous != nil || !change.FlagChanged("Docked") || !change.Pips {
+		fmt.Println("First change should be relative to an empty status")
+		t.FailNow()
+	}
+
+	// A partially written file must be skipped until it is complete.
+	time.Sleep(20 * time.Millisecond)
+	writeStatus(`{"timestamp":"2017-12-07T10:31:40Z", "event":"Status", "Flags":16842`)
+	time.Sleep(50 * time.Millisecond)
+	writeStatus(`{"timestamp":"2017-12-07T10:31:41Z", "event":"Status", "Flags":16842764, "Pips":[4,4,4], "FireGroup":0, "GuiFocus":0, "Fuel":{ "FuelMain":32.0, "FuelReservoir":0.63 }, "Cargo":0.0}`)
+
+	change = nextChange(changes)
+	// Moving pips away from engines also clears the derived FullEnginesPips flag.
+	if len(change.Flags) != 2 || change.Flags[0] != "Docked" || change.Flags[1] != "FullEnginesPips" {
+		fmt.Printf("Incorrect flag changes: Expecting [Docked FullEnginesPips], got %v\n", change.Flags)
+		t.FailNow()
+	}
+	if !change.Pips || change.FireGroup || change.GuiFocus || change.Fuel || change.Cargo {
+		fmt.Println("Incorrect value changes")
+		t.FailNow()
+	}
+	if change.Previous == nil || !change.Previous.Flags.Docked || change.Current.Flags.Docked {
+		fmt.Println("Incorrect snapshots in change")
+		t.FailNow()
+	}
+}
+
+func TestListJournalFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	names := []string{
+		"Journal.2021-05-20T194034.01.log",
+		"Journal.210101120000.01.log",
+		"Journal.200117160000.02.log",
+		"Journal.200117160000.01.log",
+		"Journal.notatimestamp.01.log",
+		"Status.json",
+	}
+	for _, name := range names {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			fmt.Println("Couldn't write file: " + err.Error())
+			t.FailNow()
+		}
+	}
+
+	files, err := elite.ListJournalFiles(dir)
+	if err != nil {
+		fmt.Println("Couldn't list journal files: " + err.Error())
+		t.FailNow()
+	}
+
+	expected := []string{
+		"Journal.200117160000.01.log",
+		"Journal.200117160000.02.log",
+		"Journal.210101120000.01.log",
+		"Journal.2021-05-20T194034.01.log",
+	}
+	if len(files) != len(expected) {
+		fmt.Printf("Incorrect number of journal files: Expecting %d, got %d\n", len(expected), len(files))
+		t.FailNow()
+	}
+	for i, file := range files {
+		if file.Name != expected[i] {
+			fmt.Printf("Incorrect journal file order: Expecting %s at %d, got %s\n", expected[i], i, file.Name)
+			t.FailNow()
+		}
+	}
+
+	if files[1].Part != 2 || files[0].Time.Year() != 2020 || files[0].Time.Hour() != 16 {
+		fmt.Println("Incorrect time or part parsed from journal file name")
+		t.FailNow()
+	}
+}
+
+func TestDiscoverLogPaths(t *testing.T) {
+	home, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(home)
+
+	for _, name := range []string{"HOME", "WINEPREFIX", elite.LogPathEnv} {
+		defer os.Setenv(name, os.Getenv(name))
+	}
+	os.Setenv("HOME", home)
+	os.Unsetenv("WINEPREFIX")
+	os.Unsetenv(elite.LogPathEnv)
+
+	makeLogPath := func(path string, modTime time.Time) string {
+		path = filepath.Join(home, filepath.FromSlash(path))
+		if err := os.MkdirAll(path, 0755); err != nil {
+			fmt.Println("Couldn't create log path: " + err.Error())
+			t.FailNow()
+		}
+		journal := filepath.Join(path, "Journal.2021-05-20T194034.01.log")
+		if err := ioutil.WriteFile(journal, nil, 0644); err != nil {
+			fmt.Println("Couldn't write journal: " + err.Error())
+			t.FailNow()
+		}
+		os.Chtimes(journal, modTime, modTime)
+		return path
+	}
+
+	now := time.Now()
+	wine := makeLogPath("Games/elite-dangerous/drive_c/users/player/Saved Games/Frontier Developments/Elite Dangerous", now.Add(-time.Hour))
+	proton := makeLogPath("library/steamapps/compatdata/359320/pfx/drive_c/users/steamuser/Saved Games/Frontier Developments/Elite Dangerous", now)
+	libraryFolders := filepath.Join(home, ".local", "share", "Steam", "steamapps")
+	os.MkdirAll(libraryFolders, 0755)
+	vdf := "\"libraryfolders\"\n{\n\t\"1\"\n\t{\n\t\t\"path\"\t\t\"" + filepath.Join(home, "library") + "\"\n\t}\n}\n"
+	if err := ioutil.WriteFile(filepath.Join(libraryFolders, "libraryfolders.vdf"), []byte(vdf), 0644); err != nil {
+		fmt.Println("Couldn't write Steam library folders: " + err.Error())
+		t.FailNow()
+	}
+
+	candidates := elite.DiscoverLogPaths()
+	if len(candidates) != 2 || candidates[0].Path != proton || candidates[1].Path != wine {
+		fmt.Printf("Incorrect log path candidates: %v\n", candidates)
+		t.FailNow()
+	}
+	if candidates[0].Source != elite.LogPathSourceProton || candidates[1].Source != elite.LogPathSourceWine {
+		fmt.Println("Incorrect log path sources")
+		t.FailNow()
+	}
+
+	// The environment variable comes first, even with older journals.
+	override := makeLogPath("override", now.Add(-24*time.Hour))
+	os.Setenv(elite.LogPathEnv, override)
+	candidates = elite.DiscoverLogPaths()
+	if len(candidates) != 3 || candidates[0].Path != override || candidates[0].Source != elite.LogPathSourceEnv {
+		fmt.Printf("Environment override was not first: %v\n", candidates)
+		t.FailNow()
+	}
+}
+
 func TestGetStarSystemFromPath(t *testing.T) {
 	sys, err := elite.GetStarSystemFromPath(testLogPath)
 	if err != nil {
@@ -140,6 +442,203 @@ func TestGetLoadoutFromPath(t *testing.T) {
 	}
 }
 
+func TestLoadoutEngineering(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	engineers := l.EngineeredModules()
+	farseer := engineers["Felicity Farseer"]
+	if len(engineers) != 3 || len(farseer) != 1 || farseer[0].Slot != "FrameShiftDrive" {
+		fmt.Println("Incorrect engineered modules by engineer")
+		t.FailNow()
+	}
+
+	fsd := farseer[0].Engineering
+	if fsd.Summary() != "FSD_LongRange G5" {
+		fmt.Println("Incorrect engineering summary: " + fsd.Summary())
+		t.FailNow()
+	}
+
+	// Mass goes from 20 to 26, which is worse because less is good.
+	mass := fsd.Modifiers[0]
+	if mass.Label != "Mass" || mass.Change() != 30 || mass.IsBuff() || !mass.IsNerf() {
+		fmt.Printf("Incorrect mass modifier: %+v changed %.1f%%\n", mass, mass.Change())
+		t.FailNow()
+	}
+	// A resistance going from -20% to -10% is an increase.
+	resistance := loadout.Modifier{Label: "ThermicResistance", Value: -0.1, OriginalValue: -0.2}
+	if math.Abs(resistance.Change()-50) > 0.0001 || !resistance.IsBuff() {
+		fmt.Printf("Incorrect resistance modifier: %+v changed %.1f%%\n", resistance, resistance.Change())
+		t.FailNow()
+	}
+	for _, buff := range fsd.Buffs() {
+		if !buff.IsBuff() || buff.IsNerf() {
+			fmt.Println("Buffs should only contain buffs")
+			t.FailNow()
+		}
+	}
+	if len(fsd.Buffs())+len(fsd.Nerfs()) != len(fsd.Modifiers) {
+		fmt.Println("Every FSD modifier should be a buff or a nerf")
+		t.FailNow()
+	}
+
+	effect := loadout.Engineering{BlueprintName: "ShieldGenerator_Thermic", Level: 5, ExperimentalEffect: "special_shield_health", ExperimentalEffectLocalised: "Hi-Cap"}
+	if effect.Summary() != "ShieldGenerator_Thermic G5 (Hi-Cap)" || (loadout.Engineering{}).Summary() != "" {
+		fmt.Println("Incorrect summary with an experimental effect: " + effect.Summary())
+		t.FailNow()
+	}
+}
+
+func TestLoadoutJumpRange(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	fsd, err := l.FSD()
+	if err != nil {
+		fmt.Println("Couldn't get FSD: " + err.Error())
+		t.FailNow()
+	}
+	if fsd.Class != 5 || fsd.Rating != "A" || math.Abs(fsd.OptimalMass-1617.84) > 0.01 {
+		fmt.Printf("Incorrect FSD: %+v\n", fsd)
+		t.FailNow()
+	}
+
+	// MaxJumpRange is written for no cargo and just enough fuel for one
+	// jump, leaving out the reserve tank.
+	unladen := fsd.JumpRange(l.UnladenMass+fsd.MaxFuelPerJump, fsd.MaxFuelPerJump)
+	if math.Abs(unladen-l.MaxJumpRange) > 0.001 {
+		fmt.Printf("Expected jump range %.3f, got %.3f\n", l.MaxJumpRange, unladen)
+		t.FailNow()
+	}
+	maxRange, err := l.JumpRange(fsd.MaxFuelPerJump, 0)
+	reserveMass := l.UnladenMass + l.FuelCapacity.Reserve + fsd.MaxFuelPerJump
+	if err != nil || maxRange >= unladen || math.Abs(maxRange-fsd.JumpRange(reserveMass, fsd.MaxFuelPerJump)) > 0.001 {
+		fmt.Printf("Expected the reserve tank to shorten the range, got %.3f (%v)\n", maxRange, err)
+		t.FailNow()
+	}
+	laden, err := l.JumpRange(l.FuelCapacity.Main, float64(l.CargoCapacity))
+	if err != nil || laden >= maxRange {
+		fmt.Printf("Jump range should drop with a full tank and hold, got %.3f (%v)\n", laden, err)
+		t.FailNow()
+	}
+	if _, err := l.JumpRange(l.FuelCapacity.Main+1, 0); err == nil {
+		fmt.Println("Expected an error for more fuel than the main tank holds")
+		t.FailNow()
+	}
+	if _, err := l.JumpRange(-1, 0); err == nil {
+		fmt.Println("Expected an error for negative fuel")
+		t.FailNow()
+	}
+
+	boosted := append([]loadout.Module{{Slot: "Slot03_Size5", Item: "int_guardianfsdbooster_size5"}}, l.Modules...)
+	boostedFSD, err := loadout.FindFSD(boosted)
+	if err != nil || boostedFSD.GuardianBonus != 10.5 {
+		fmt.Printf("Incorrect Guardian bonus: %+v (%v)\n", boostedFSD, err)
+		t.FailNow()
+	}
+	sco, err := loadout.NewFSD("int_hyperdrive_overcharge_size5_class5")
+	if err != nil || !sco.Overcharge || sco.Class != 5 || sco.Rating != "A" || sco.JumpRange(l.UnladenMass, sco.MaxFuelPerJump) <= 0 {
+		fmt.Printf("Incorrect SCO FSD: %+v (%v)\n", sco, err)
+		t.FailNow()
+	}
+	if _, err := loadout.NewFSD("int_hyperdrive_size9_class5"); err == nil {
+		fmt.Println("Expected an error for an unknown FSD")
+		t.FailNow()
+	}
+}
+
+func TestModuleCatalogue(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	ship, ok := l.ShipInfo()
+	if !ok || ship.Name != "Krait Phantom" || ship.Pad != "M" {
+		fmt.Printf("Incorrect ship: %+v\n", ship)
+		t.FailNow()
+	}
+
+	for _, module := range l.Modules {
+		item, ok := module.Info()
+		if !ok {
+			fmt.Printf("Couldn't decode %s: %+v\n", module.Item, item)
+			t.FailNow()
+		}
+		if module.Slot != "FrameShiftDrive" {
+			continue
+		}
+		if item.Name != "5A Frame Shift Drive" || item.Category != catalogue.CategoryCore || item.Stats == nil || item.Stats.Mass != 20 {
+			fmt.Printf("Incorrect FSD: %+v\n", item)
+			t.FailNow()
+		}
+	}
+
+	tests := map[string]string{
+		"hpt_pulselaser_gimbal_medium":          "Pulse Laser (Gimballed, Medium)",
+		"Hpt_ShieldBooster_Size0_Class5":        "0A Shield Booster",
+		"$int_cargorack_size4_class1_name;":     "4E Cargo Rack",
+		"int_shieldgenerator_size5_class3_fast": "5C Bi-Weave Shield Generator",
+		"krait_light_armour_grade3":             "Krait Phantom Military Grade Composite",
+	}
+	for symbol, name := range tests {
+		item, ok := catalogue.Lookup(symbol)
+		if !ok || item.Name != name {
+			fmt.Printf("Expected %s to be %q, got %+v\n", symbol, name, item)
+			t.FailNow()
+		}
+	}
+	if item, _ := catalogue.Lookup("hpt_heatsinklauncher_turret_tiny"); item.Category != catalogue.CategoryUtility {
+		fmt.Printf("Expected a utility, got %+v\n", item)
+		t.FailNow()
+	}
+	for _, symbol := range []string{"int_engine_size3_class5_fast", "int_hyperdrive_overcharge_size5_class5", "int_guardianpowerplant_size6"} {
+		if item, _ := catalogue.Lookup(symbol); item.Category != catalogue.CategoryCore {
+			fmt.Printf("Expected %s to be a core module, got %+v\n", symbol, item)
+			t.FailNow()
+		}
+	}
+	if item, ok := catalogue.Lookup("krait_light_shipkit1_wings2"); !ok || item.Category != catalogue.CategoryCosmetic {
+		fmt.Printf("Expected a cosmetic, got %+v\n", item)
+		t.FailNow()
+	}
+	tank := loadout.Module{Slot: "Slot05_Size3", Item: "int_fueltank_size3_class3"}
+	if item, _ := tank.Info(); item.Category != catalogue.CategoryOptional {
+		fmt.Printf("Expected a fuel tank in an optional slot to be optional, got %+v\n", item)
+		t.FailNow()
+	}
+
+	stats := map[string]catalogue.Stats{
+		"int_engine_size3_class5":       {Mass: 5, PowerDraw: 3.72, Integrity: 70},
+		"int_powerplant_size2_class1":   {Mass: 2.5, Integrity: 46},
+		"hpt_multicannon_gimbal_medium": {Mass: 4, PowerDraw: 0.64, Integrity: 51},
+	}
+	for symbol, expected := range stats {
+		if item, _ := catalogue.Lookup(symbol); item.Stats == nil || *item.Stats != expected {
+			fmt.Printf("Expected %s to have stats %+v, got %+v\n", symbol, expected, item.Stats)
+			t.FailNow()
+		}
+	}
+
+	if _, ok := catalogue.Lookup("int_notamodule_size1_class1"); ok {
+		fmt.Println("Expected an unknown module not to decode")
+		t.FailNow()
+	}
+
+	catalogue.Register("int_cargorack_size4_class1", catalogue.Stats{Mass: 16, Integrity: 10})
+	if item, _ := catalogue.Lookup("int_cargorack_size4_class1"); item.Stats == nil || item.Stats.Mass != 16 {
+		fmt.Printf("Expected registered stats, got %+v\n", item)
+		t.FailNow()
+	}
+}
+
 func TestGetStatisticsFromPath(t *testing.T) {
 	stats, err := elite.GetStatisticsFromPath(testLogPath)
 	if err != nil {
@@ -165,3 +664,82 @@ func Example() {
 		fmt.Println("Ship is not docked")
 	}
 }
+
+func TestLoadoutPowerBudget(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	budget, err := l.PowerBudget()
+	if err != nil {
+		fmt.Println("Couldn't get power budget: " + err.Error())
+		t.FailNow()
+	}
+	if math.Abs(budget.Capacity-22.372679) > 0.0001 || math.Abs(budget.HeatEfficiency-0.36104) > 0.0001 {
+		fmt.Printf("Incorrect power plant: %+v\n", budget)
+		t.FailNow()
+	}
+	// The engineered thrusters and FSD are in the first priority group,
+	// with most of the other core and optional modules.
+	if math.Abs(budget.Groups[0].Retracted-(8.4672+0.69+0.56+0.67+0.45+0.7+2.28+0.98+0.97)) > 0.0001 {
+		fmt.Printf("Incorrect priority 1 power: %+v\n", budget.Groups[0])
+		t.FailNow()
+	}
+	if !budget.Complete() {
+		fmt.Printf("Power draw should be known for every module: %+v\n", budget.Unknown)
+		t.FailNow()
+	}
+
+	modules := []loadout.Module{
+		{Slot: "PowerPlant", Item: "int_powerplant_size2_class1", On: true},
+		{Slot: "FrameShiftDrive", Item: "int_hyperdrive_size5_class5", On: true},
+		{Slot: "MediumHardpoint1", Item: "hpt_pulselaser_fixed_medium", On: true, Priority: 1,
+			Engineering: loadout.Engineering{Modifiers: []loadout.Modifier{{Label: "PowerDraw", Value: 6}}}},
+		{Slot: "TinyHardpoint1", Item: "hpt_shieldbooster_size0_class5", On: true, Priority: 2,
+			Engineering: loadout.Engineering{Modifiers: []loadout.Modifier{{Label: "PowerDraw", Value: 2}}}},
+		{Slot: "Slot01_Size2", Item: "int_cargorack_size2_class1", On: false, Priority: 4},
+	}
+	budget, err = loadout.NewPowerBudget(modules)
+	if err != nil {
+		fmt.Println("Couldn't get power budget: " + err.Error())
+		t.FailNow()
+	}
+	if budget.Capacity != 6.4 || budget.Retracted != 2.6 || budget.Deployed != 8.6 || !budget.Complete() {
+		fmt.Printf("Incorrect power budget: %+v\n", budget)
+		t.FailNow()
+	}
+	if budget.HeatEfficiency != 1 || budget.Heat(false) != 2.6 {
+		fmt.Printf("Incorrect heat for a stock power plant: %+v\n", budget)
+		t.FailNow()
+	}
+	if budget.Overloaded(false) || !budget.Overloaded(true) {
+		fmt.Println("Ship should only be overloaded with hardpoints deployed")
+		t.FailNow()
+	}
+	shutDown := budget.ShutDown(true)
+	if len(shutDown) != 2 || shutDown[0].Slot != "MediumHardpoint1" || shutDown[1].Slot != "TinyHardpoint1" {
+		fmt.Printf("Incorrect modules shut down: %+v\n", shutDown)
+		t.FailNow()
+	}
+
+	if _, err := loadout.NewPowerBudget(modules[1:]); err == nil {
+		fmt.Println("Expected an error without a power plant")
+		t.FailNow()
+	}
+
+	modules = []loadout.Module{
+		{Slot: "PowerPlant", Item: "int_guardianpowerplant_size5", On: true},
+		{Slot: "Slot01_Size2", Item: "int_notamodule_size2_class1", On: true},
+	}
+	budget, err = loadout.NewPowerBudget(modules)
+	if err != nil {
+		fmt.Println("Couldn't get power budget with a Guardian power plant: " + err.Error())
+		t.FailNow()
+	}
+	if budget.Capacity != 26.9 || budget.Complete() || len(budget.Unknown) != 1 {
+		fmt.Printf("Incorrect power budget with an unknown module: %+v\n", budget)
+		t.FailNow()
+	}
+}
@@ -0,0 +1,35 @@
+package elite
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when the journal files contain none of the requested events.
+	ErrNotFound = errors.New("No matching event found in all log files")
+	// ErrLogDirMissing is returned when the log directory doesn't exist.
+	ErrLogDirMissing = errors.New("Log directory does not exist")
+)
+
+// ParseError describes a journal line that couldn't be parsed.
+// It is only returned by readers in strict mode; otherwise such lines are skipped.
+type ParseError struct {
+	// File is the name of the journal file.
+	File string
+	// Line is the line number within the file, starting at 1.
+	Line int
+	// Text is the content of the line.
+	Text string
+	// Err is the error returned when parsing the line.
+	Err error
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("Couldn't parse %s line %d: %v", err.File, err.Line, err.Err)
+}
+
+// Unwrap returns the underlying parse error.
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
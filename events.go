@@ -0,0 +1,216 @@
+package elite
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Event is implemented by every event read from the journal.
+// All event types embed a *JournalEntry, which provides these methods.
+type Event interface {
+	EventName() string
+	EventTime() time.Time
+}
+
+// EventName returns the name of the event, such as "FSDJump".
+func (entry *JournalEntry) EventName() string {
+	return entry.Event
+}
+
+// EventTime returns the parsed timestamp of the event.
+// The zero time is returned if the timestamp is missing or malformed.
+func (entry *JournalEntry) EventTime() time.Time {
+	t, _ := time.Parse(time.RFC3339, entry.Timestamp)
+	return t
+}
+
+// UnknownEvent is returned by ParseEvent for events that have no registered type.
+// The original line is kept in Raw so that it can be decoded by the caller.
+type UnknownEvent struct {
+	*JournalEntry
+	Raw json.RawMessage
+}
+
+var (
+	eventTypesMu sync.RWMutex
+	eventTypes   = map[string]func() Event{}
+)
+
+// RegisterEvent associates an event name with a function returning a new,
+// empty value that events of that name are decoded into. The value must be
+// a pointer to a struct embedding *JournalEntry. Registering a name that is
+// already registered replaces the previous type, which allows callers to
+// extend or override the types provided by this package.
+func RegisterEvent(name string, newEvent func() Event) {
+	eventTypesMu.Lock()
+	defer eventTypesMu.Unlock()
+	eventTypes[name] = newEvent
+}
+
+// ParseEvent decodes a single journal line into the type registered for its
+// event name. Events without a registered type are returned as an *UnknownEvent.
+//
+// Fields whose type doesn't match the journal are left at their zero value
+// rather than discarding the whole event.
+func ParseEvent(line []byte) (Event, error) {
+	entry, err := parseHeader(line)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypesMu.RLock()
+	newEvent, ok := eventTypes[entry.Event]
+	eventTypesMu.RUnlock()
+	if !ok {
+		raw := make([]byte, len(line))
+		copy(raw, line)
+		return &UnknownEvent{JournalEntry: entry, Raw: raw}, nil
+	}
+
+	event := newEvent()
+	if err := decodeEvent(line, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// decodeEvent decodes a journal line into the given event. Fields whose type
+// doesn't match the journal are left at their zero value rather than failing.
+func decodeEvent(line []byte, event interface{}) error {
+	err := json.Unmarshal(line, event)
+	if _, ok := err.(*json.UnmarshalTypeError); ok {
+		return nil
+	}
+	return err
+}
+
+func init() {
+	events := map[string]func() Event{
+		// Startup
+		"Fileheader": func() Event { return &FileHeader{} },
+		// Also accept the capitalised name used by the test journal.
+		"FileHeader": func() Event { return &FileHeader{} },
+		"Continued":  func() Event { return &Continued{} },
+		"Commander":  func() Event { return &Commander{} },
+		"LoadGame":   func() Event { return &LoadGame{} },
+		"Materials":  func() Event { return &Materials{} },
+		"Rank":       func() Event { return &Rank{} },
+		"Progress":   func() Event { return &Progress{} },
+		"Reputation": func() Event { return &Reputation{} },
+		"Cargo":      func() Event { return &Cargo{} },
+		"Backpack":   func() Event { return &Backpack{} },
+		"ShipLocker": func() Event { return &ShipLocker{} },
+		"Missions":   func() Event { return &Missions{} },
+		"Loadout":    func() Event { return &Loadout{} },
+		"Statistics": func() Event { return &Statistics{} },
+		"Shutdown":   func() Event { return &Shutdown{} },
+
+		// Travel
+		"Location":         func() Event { return &Location{} },
+		"FSDJump":          func() Event { return &FSDJump{} },
+		"FSDTarget":        func() Event { return &FSDTarget{} },
+		"NavRoute":         func() Event { return &NavRoute{} },
+		"StartJump":        func() Event { return &StartJump{} },
+		"SupercruiseEntry": func() Event { return &SupercruiseEntry{} },
+		"SupercruiseExit":  func() Event { return &SupercruiseExit{} },
+		"ApproachBody":     func() Event { return &ApproachBody{} },
+		"LeaveBody":        func() Event { return &LeaveBody{} },
+		"Touchdown":        func() Event { return &Touchdown{} },
+		"Liftoff":          func() Event { return &Liftoff{} },
+		"DockingRequested": func() Event { return &DockingRequested{} },
+		"DockingGranted":   func() Event { return &DockingGranted{} },
+		"DockingDenied":    func() Event { return &DockingDenied{} },
+		"Docked":           func() Event { return &Docked{} },
+		"Undocked":         func() Event { return &Undocked{} },
+		"Embark":           func() Event { return &Embark{} },
+		"Disembark":        func() Event { return &Disembark{} },
+		"FuelScoop":        func() Event { return &FuelScoop{} },
+		"JetConeBoost":     func() Event { return &JetConeBoost{} },
+
+		// Station services
+		"Market":        func() Event { return &Market{} },
+		"Outfitting":    func() Event { return &Outfitting{} },
+		"Shipyard":      func() Event { return &Shipyard{} },
+		"FCMaterials":   func() Event { return &FCMaterials{} },
+		"MarketBuy":     func() Event { return &MarketBuy{} },
+		"MarketSell":    func() Event { return &MarketSell{} },
+		"RefuelAll":     func() Event { return &Refuel{} },
+		"RefuelPartial": func() Event { return &Refuel{} },
+		"Repair":        func() Event { return &Repair{} },
+		"RepairAll":     func() Event { return &Repair{} },
+		"BuyAmmo":       func() Event { return &BuyAmmo{} },
+		"BuyDrones":     func() Event { return &BuyDrones{} },
+		"SellDrones":    func() Event { return &SellDrones{} },
+		"PayFines":      func() Event { return &PayFines{} },
+		"PayBounties":   func() Event { return &PayFines{} },
+		"RedeemVoucher": func() Event { return &RedeemVoucher{} },
+
+		// Shipyard and outfitting
+		"ShipyardBuy":       func() Event { return &ShipyardBuy{} },
+		"ShipyardSell":      func() Event { return &ShipyardSell{} },
+		"ShipyardSwap":      func() Event { return &ShipyardSwap{} },
+		"ShipyardTransfer":  func() Event { return &ShipyardTransfer{} },
+		"ShipyardNew":       func() Event { return &ShipyardNew{} },
+		"StoredShips":       func() Event { return &StoredShips{} },
+		"StoredModules":     func() Event { return &StoredModulesEvent{} },
+		"ModuleBuy":         func() Event { return &ModuleBuy{} },
+		"ModuleSell":        func() Event { return &ModuleSell{} },
+		"ModuleSellRemote":  func() Event { return &ModuleSellRemote{} },
+		"ModuleStore":       func() Event { return &ModuleStore{} },
+		"ModuleRetrieve":    func() Event { return &ModuleRetrieve{} },
+		"ModuleSwap":        func() Event { return &ModuleSwap{} },
+		"FetchRemoteModule": func() Event { return &FetchRemoteModule{} },
+		"MassModuleStore":   func() Event { return &MassModuleStore{} },
+		"SetUserShipName":   func() Event { return &SetUserShipName{} },
+		"ModuleInfo":        func() Event { return &ModulesInfo{} },
+
+		// Combat
+		"Bounty":             func() Event { return &Bounty{} },
+		"FactionKillBond":    func() Event { return &FactionKillBond{} },
+		"Interdicted":        func() Event { return &Interdicted{} },
+		"Interdiction":       func() Event { return &Interdiction{} },
+		"EscapeInterdiction": func() Event { return &EscapeInterdiction{} },
+		"Died":               func() Event { return &Died{} },
+		"HullDamage":         func() Event { return &HullDamage{} },
+		"ShieldState":        func() Event { return &ShieldState{} },
+		"UnderAttack":        func() Event { return &UnderAttack{} },
+		"ShipTargeted":       func() Event { return &ShipTargeted{} },
+		"PVPKill":            func() Event { return &PVPKill{} },
+
+		// Exploration
+		"Scan":                     func() Event { return &Scan{} },
+		"FSSDiscoveryScan":         func() Event { return &FSSDiscoveryScan{} },
+		"FSSAllBodiesFound":        func() Event { return &FSSAllBodiesFound{} },
+		"SAAScanComplete":          func() Event { return &SAAScanComplete{} },
+		"ScanOrganic":              func() Event { return &ScanOrganic{} },
+		"SellExplorationData":      func() Event { return &SellExplorationData{} },
+		"MultiSellExplorationData": func() Event { return &SellExplorationData{} },
+
+		// Missions
+		"MissionAccepted":   func() Event { return &MissionAccepted{} },
+		"MissionCompleted":  func() Event { return &MissionCompleted{} },
+		"MissionFailed":     func() Event { return &MissionFailed{} },
+		"MissionAbandoned":  func() Event { return &MissionFailed{} },
+		"MissionRedirected": func() Event { return &MissionRedirected{} },
+
+		// Engineering and materials
+		"MaterialCollected": func() Event { return &MaterialCollected{} },
+		"MaterialDiscarded": func() Event { return &MaterialCollected{} },
+		"EngineerCraft":     func() Event { return &EngineerCraft{} },
+
+		// Cargo
+		"CollectCargo":  func() Event { return &CollectCargo{} },
+		"EjectCargo":    func() Event { return &EjectCargo{} },
+		"MiningRefined": func() Event { return &MiningRefined{} },
+
+		// Social
+		"ReceiveText": func() Event { return &ReceiveText{} },
+		"SendText":    func() Event { return &SendText{} },
+		"Promotion":   func() Event { return &Promotion{} },
+	}
+
+	for name, newEvent := range events {
+		RegisterEvent(name, newEvent)
+	}
+}
@@ -0,0 +1,121 @@
+package elite
+
+// BountyReward is the share of a bounty paid by a single faction.
+type BountyReward struct {
+	Faction string `json:"Faction"`
+	Reward  int64  `json:"Reward"`
+}
+
+// Bounty is written when the player is awarded a bounty for a kill.
+type Bounty struct {
+	*JournalEntry
+	Rewards          []BountyReward `json:"Rewards"`
+	Target           string         `json:"Target"`
+	TargetLocalised  string         `json:"Target_Localised"`
+	TotalReward      int64          `json:"TotalReward"`
+	VictimFaction    string         `json:"VictimFaction"`
+	SharedWithOthers int64          `json:"SharedWithOthers"`
+}
+
+// FactionKillBond is written when the player is awarded a combat bond for a kill.
+type FactionKillBond struct {
+	*JournalEntry
+	Reward          int64  `json:"Reward"`
+	AwardingFaction string `json:"AwardingFaction"`
+	VictimFaction   string `json:"VictimFaction"`
+}
+
+// Interdicted is written when the player's ship is interdicted.
+type Interdicted struct {
+	*JournalEntry
+	Submitted   bool   `json:"Submitted"`
+	Interdictor string `json:"Interdictor"`
+	IsPlayer    bool   `json:"IsPlayer"`
+	CombatRank  int64  `json:"CombatRank"`
+	Faction     string `json:"Faction"`
+	Power       string `json:"Power"`
+}
+
+// Interdiction is written when the player interdicts another ship.
+type Interdiction struct {
+	*JournalEntry
+	Success     bool   `json:"Success"`
+	Interdicted string `json:"Interdicted"`
+	IsPlayer    bool   `json:"IsPlayer"`
+	CombatRank  int64  `json:"CombatRank"`
+	Faction     string `json:"Faction"`
+	Power       string `json:"Power"`
+}
+
+// EscapeInterdiction is written when the player escapes an interdiction.
+type EscapeInterdiction struct {
+	*JournalEntry
+	Interdictor string `json:"Interdictor"`
+	IsPlayer    bool   `json:"IsPlayer"`
+}
+
+// Killer identifies one of the ships involved in killing the player.
+type Killer struct {
+	Name string `json:"Name"`
+	Ship string `json:"Ship"`
+	Rank string `json:"Rank"`
+}
+
+// Died is written when the player dies. If killed by a wing, the killers
+// are listed in Killers instead of the single Killer fields.
+type Died struct {
+	*JournalEntry
+	KillerName          string   `json:"KillerName"`
+	KillerNameLocalised string   `json:"KillerName_Localised"`
+	KillerShip          string   `json:"KillerShip"`
+	KillerRank          string   `json:"KillerRank"`
+	Killers             []Killer `json:"Killers"`
+}
+
+// HullDamage is written when the hull of the player's ship or fighter
+// drops below a threshold. Health is a fraction from 0 to 1.
+type HullDamage struct {
+	*JournalEntry
+	Health      float64 `json:"Health"`
+	PlayerPilot bool    `json:"PlayerPilot"`
+	Fighter     bool    `json:"Fighter"`
+}
+
+// ShieldState is written when the ship's shields fail or come back online.
+type ShieldState struct {
+	*JournalEntry
+	ShieldsUp bool `json:"ShieldsUp"`
+}
+
+// UnderAttack is written when the player or a crew member is under fire.
+type UnderAttack struct {
+	*JournalEntry
+	Target string `json:"Target"`
+}
+
+// ShipTargeted is written when the player targets a ship, and again as the
+// scan of the target progresses. Later fields are only set at higher scan stages.
+type ShipTargeted struct {
+	*JournalEntry
+	TargetLocked       bool    `json:"TargetLocked"`
+	Ship               string  `json:"Ship"`
+	ShipLocalised      string  `json:"Ship_Localised"`
+	ScanStage          int64   `json:"ScanStage"`
+	PilotName          string  `json:"PilotName"`
+	PilotNameLocalised string  `json:"PilotName_Localised"`
+	PilotRank          string  `json:"PilotRank"`
+	ShieldHealth       float64 `json:"ShieldHealth"`
+	HullHealth         float64 `json:"HullHealth"`
+	Faction            string  `json:"Faction"`
+	LegalStatus        string  `json:"LegalStatus"`
+	Bounty             int64   `json:"Bounty"`
+	Subsystem          string  `json:"Subsystem"`
+	SubsystemHealth    float64 `json:"SubsystemHealth"`
+}
+
+// PVPKill is written when the player kills another player.
+type PVPKill struct {
+	*JournalEntry
+	Victim     string `json:"Victim"`
+	CombatRank int64  `json:"CombatRank"`
+}
@@ -0,0 +1,132 @@
+package elite
+
+// Ring describes a planetary ring or asteroid belt.
+type Ring struct {
+	Name      string  `json:"Name"`
+	RingClass string  `json:"RingClass"`
+	MassMT    float64 `json:"MassMT"`
+	InnerRad  float64 `json:"InnerRad"`
+	OuterRad  float64 `json:"OuterRad"`
+}
+
+// Proportion is the percentage of a body made up by a single element or compound.
+type Proportion struct {
+	Name    string  `json:"Name"`
+	Percent float64 `json:"Percent"`
+}
+
+// Composition is the proportion of a body's solid mass made up of ice, rock and metal.
+type Composition struct {
+	Ice   float64 `json:"Ice"`
+	Rock  float64 `json:"Rock"`
+	Metal float64 `json:"Metal"`
+}
+
+// Scan is written when a body is scanned. Star fields and planet fields are
+// only set for the respective kind of body.
+type Scan struct {
+	*JournalEntry
+	ScanType              string             `json:"ScanType"`
+	BodyName              string             `json:"BodyName"`
+	BodyID                int64              `json:"BodyID"`
+	Parents               []map[string]int64 `json:"Parents"`
+	StarSystem            string             `json:"StarSystem"`
+	SystemAddress         int64              `json:"SystemAddress"`
+	DistanceFromArrivalLS float64            `json:"DistanceFromArrivalLS"`
+	WasDiscovered         bool               `json:"WasDiscovered"`
+	WasMapped             bool               `json:"WasMapped"`
+
+	// Stars
+	StarType          string  `json:"StarType"`
+	Subclass          int64   `json:"Subclass"`
+	StellarMass       float64 `json:"StellarMass"`
+	AbsoluteMagnitude float64 `json:"AbsoluteMagnitude"`
+	AgeMY             int64   `json:"Age_MY"`
+	Luminosity        string  `json:"Luminosity"`
+
+	// Planets
+	PlanetClass           string       `json:"PlanetClass"`
+	TidalLock             bool         `json:"TidalLock"`
+	TerraformState        string       `json:"TerraformState"`
+	Atmosphere            string       `json:"Atmosphere"`
+	AtmosphereType        string       `json:"AtmosphereType"`
+	AtmosphereComposition []Proportion `json:"AtmosphereComposition"`
+	Volcanism             string       `json:"Volcanism"`
+	MassEM                float64      `json:"MassEM"`
+	SurfaceGravity        float64      `json:"SurfaceGravity"`
+	SurfacePressure       float64      `json:"SurfacePressure"`
+	Landable              bool         `json:"Landable"`
+	Materials             []Proportion `json:"Materials"`
+	Composition           Composition  `json:"Composition"`
+
+	// Stars and planets
+	Radius             float64 `json:"Radius"`
+	SurfaceTemperature float64 `json:"SurfaceTemperature"`
+	SemiMajorAxis      float64 `json:"SemiMajorAxis"`
+	Eccentricity       float64 `json:"Eccentricity"`
+	OrbitalInclination float64 `json:"OrbitalInclination"`
+	Periapsis          float64 `json:"Periapsis"`
+	OrbitalPeriod      float64 `json:"OrbitalPeriod"`
+	RotationPeriod     float64 `json:"RotationPeriod"`
+	AxialTilt          float64 `json:"AxialTilt"`
+	Rings              []Ring  `json:"Rings"`
+	ReserveLevel       string  `json:"ReserveLevel"`
+}
+
+// FSSDiscoveryScan is written when the discovery scanner is used.
+type FSSDiscoveryScan struct {
+	*JournalEntry
+	Progress      float64 `json:"Progress"`
+	BodyCount     int64   `json:"BodyCount"`
+	NonBodyCount  int64   `json:"NonBodyCount"`
+	SystemName    string  `json:"SystemName"`
+	SystemAddress int64   `json:"SystemAddress"`
+}
+
+// FSSAllBodiesFound is written when every body in the system has been found with the FSS.
+type FSSAllBodiesFound struct {
+	*JournalEntry
+	SystemName    string `json:"SystemName"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Count         int64  `json:"Count"`
+}
+
+// SAAScanComplete is written when a body has been fully mapped with probes.
+type SAAScanComplete struct {
+	*JournalEntry
+	BodyName         string `json:"BodyName"`
+	SystemAddress    int64  `json:"SystemAddress"`
+	BodyID           int64  `json:"BodyID"`
+	ProbesUsed       int64  `json:"ProbesUsed"`
+	EfficiencyTarget int64  `json:"EfficiencyTarget"`
+}
+
+// ScanOrganic is written when an organic sample is scanned on foot.
+type ScanOrganic struct {
+	*JournalEntry
+	ScanType         string `json:"ScanType"`
+	Genus            string `json:"Genus"`
+	GenusLocalised   string `json:"Genus_Localised"`
+	Species          string `json:"Species"`
+	SpeciesLocalised string `json:"Species_Localised"`
+	Variant          string `json:"Variant"`
+	VariantLocalised string `json:"Variant_Localised"`
+	SystemAddress    int64  `json:"SystemAddress"`
+	Body             int64  `json:"Body"`
+}
+
+// DiscoveredSystem is a system whose exploration data was sold.
+type DiscoveredSystem struct {
+	SystemName string `json:"SystemName"`
+	NumBodies  int64  `json:"NumBodies"`
+}
+
+// SellExplorationData is written for both the SellExplorationData and
+// MultiSellExplorationData events.
+type SellExplorationData struct {
+	*JournalEntry
+	Discovered    []DiscoveredSystem `json:"Discovered"`
+	BaseValue     int64              `json:"BaseValue"`
+	Bonus         int64              `json:"Bonus"`
+	TotalEarnings int64              `json:"TotalEarnings"`
+}
@@ -0,0 +1,98 @@
+package elite
+
+import (
+	"github.com/BenJuan26/elite/loadout"
+)
+
+// MaterialCollected is written for both the MaterialCollected and
+// MaterialDiscarded events. Category is "Raw", "Manufactured" or "Encoded".
+type MaterialCollected struct {
+	*JournalEntry
+	Category      string `json:"Category"`
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Count         int64  `json:"Count"`
+}
+
+// CollectCargo is written when a cargo canister is scooped into the hold.
+type CollectCargo struct {
+	*JournalEntry
+	Type          string `json:"Type"`
+	TypeLocalised string `json:"Type_Localised"`
+	Stolen        bool   `json:"Stolen"`
+	MissionID     int64  `json:"MissionID,omitempty"`
+}
+
+// EjectCargo is written when cargo is jettisoned or abandoned.
+type EjectCargo struct {
+	*JournalEntry
+	Type          string `json:"Type"`
+	TypeLocalised string `json:"Type_Localised"`
+	Count         int64  `json:"Count"`
+	Abandoned     bool   `json:"Abandoned"`
+	MissionID     int64  `json:"MissionID,omitempty"`
+}
+
+// MiningRefined is written when the refinery produces a ton of a
+// commodity. Type is written as a symbol, such as "$platinum_name;".
+type MiningRefined struct {
+	*JournalEntry
+	Type          string `json:"Type"`
+	TypeLocalised string `json:"Type_Localised"`
+}
+
+// Ingredient is a material consumed by an engineering blueprint.
+type Ingredient struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Count         int64  `json:"Count"`
+}
+
+// EngineerCraft is written when a module is modified by an engineer.
+type EngineerCraft struct {
+	*JournalEntry
+	Slot                        string             `json:"Slot"`
+	Module                      string             `json:"Module"`
+	Engineer                    string             `json:"Engineer"`
+	EngineerID                  int64              `json:"EngineerID"`
+	BlueprintID                 int64              `json:"BlueprintID"`
+	BlueprintName               string             `json:"BlueprintName"`
+	Level                       int64              `json:"Level"`
+	Quality                     float64            `json:"Quality"`
+	ApplyExperimentalEffect     string             `json:"ApplyExperimentalEffect"`
+	ExperimentalEffect          string             `json:"ExperimentalEffect"`
+	ExperimentalEffectLocalised string             `json:"ExperimentalEffect_Localised"`
+	Ingredients                 []Ingredient       `json:"Ingredients"`
+	Modifiers                   []loadout.Modifier `json:"Modifiers"`
+}
+
+// ReceiveText is written when a text message is received.
+type ReceiveText struct {
+	*JournalEntry
+	From             string `json:"From"`
+	FromLocalised    string `json:"From_Localised"`
+	Message          string `json:"Message"`
+	MessageLocalised string `json:"Message_Localised"`
+	Channel          string `json:"Channel"`
+}
+
+// SendText is written when the player sends a text message.
+type SendText struct {
+	*JournalEntry
+	To      string `json:"To"`
+	Message string `json:"Message"`
+}
+
+// Promotion is written when the player is promoted. Only the category
+// that was promoted is set, to the new rank.
+type Promotion struct {
+	*JournalEntry
+	Combat       int64 `json:"Combat,omitempty"`
+	Trade        int64 `json:"Trade,omitempty"`
+	Explore      int64 `json:"Explore,omitempty"`
+	Soldier      int64 `json:"Soldier,omitempty"`
+	Exobiologist int64 `json:"Exobiologist,omitempty"`
+	Empire       int64 `json:"Empire,omitempty"`
+	Federation   int64 `json:"Federation,omitempty"`
+	CQC          int64 `json:"CQC,omitempty"`
+}
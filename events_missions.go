@@ -0,0 +1,86 @@
+package elite
+
+// MissionAccepted is written when the player accepts a mission.
+// Fields describing the mission's objective are only set for the relevant mission types.
+type MissionAccepted struct {
+	*JournalEntry
+	MissionID           int64  `json:"MissionID"`
+	Name                string `json:"Name"`
+	LocalisedName       string `json:"LocalisedName"`
+	Faction             string `json:"Faction"`
+	Expiry              string `json:"Expiry"`
+	Wing                bool   `json:"Wing"`
+	Influence           string `json:"Influence"`
+	Reputation          string `json:"Reputation"`
+	Reward              int64  `json:"Reward"`
+	Commodity           string `json:"Commodity"`
+	CommodityLocalised  string `json:"Commodity_Localised"`
+	Count               int64  `json:"Count"`
+	Target              string `json:"Target"`
+	TargetLocalised     string `json:"Target_Localised"`
+	TargetType          string `json:"TargetType"`
+	TargetTypeLocalised string `json:"TargetType_Localised"`
+	TargetFaction       string `json:"TargetFaction"`
+	KillCount           int64  `json:"KillCount"`
+	DestinationSystem   string `json:"DestinationSystem"`
+	DestinationStation  string `json:"DestinationStation"`
+	PassengerCount      int64  `json:"PassengerCount"`
+	PassengerVIPs       bool   `json:"PassengerVIPs"`
+	PassengerWanted     bool   `json:"PassengerWanted"`
+	PassengerType       string `json:"PassengerType"`
+}
+
+// MaterialReward is a material awarded on completion of a mission.
+type MaterialReward struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Category      string `json:"Category"`
+	Count         int64  `json:"Count"`
+}
+
+// CommodityReward is a commodity awarded on completion of a mission.
+type CommodityReward struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Count         int64  `json:"Count"`
+}
+
+// MissionCompleted is written when the player hands in a mission.
+type MissionCompleted struct {
+	*JournalEntry
+	MissionID          int64             `json:"MissionID"`
+	Name               string            `json:"Name"`
+	LocalisedName      string            `json:"LocalisedName"`
+	Faction            string            `json:"Faction"`
+	Commodity          string            `json:"Commodity"`
+	CommodityLocalised string            `json:"Commodity_Localised"`
+	Count              int64             `json:"Count"`
+	TargetFaction      string            `json:"TargetFaction"`
+	DestinationSystem  string            `json:"DestinationSystem"`
+	DestinationStation string            `json:"DestinationStation"`
+	Reward             int64             `json:"Reward"`
+	Donation           string            `json:"Donation"`
+	Donated            int64             `json:"Donated"`
+	MaterialsReward    []MaterialReward  `json:"MaterialsReward"`
+	CommodityReward    []CommodityReward `json:"CommodityReward"`
+}
+
+// MissionFailed is written for both the MissionFailed and MissionAbandoned events.
+type MissionFailed struct {
+	*JournalEntry
+	MissionID     int64  `json:"MissionID"`
+	Name          string `json:"Name"`
+	LocalisedName string `json:"LocalisedName"`
+	Fine          int64  `json:"Fine"`
+}
+
+// MissionRedirected is written when a mission's destination changes.
+type MissionRedirected struct {
+	*JournalEntry
+	MissionID             int64  `json:"MissionID"`
+	Name                  string `json:"Name"`
+	NewDestinationStation string `json:"NewDestinationStation"`
+	NewDestinationSystem  string `json:"NewDestinationSystem"`
+	OldDestinationStation string `json:"OldDestinationStation"`
+	OldDestinationSystem  string `json:"OldDestinationSystem"`
+}
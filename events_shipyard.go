@@ -0,0 +1,254 @@
+package elite
+
+// ShipyardBuy is written when a new ship is bought. The previous ship is
+// either stored (StoreShipID) or sold (SellShipID).
+type ShipyardBuy struct {
+	*JournalEntry
+	MarketID          int64  `json:"MarketID"`
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	ShipPrice         int64  `json:"ShipPrice"`
+	StoreOldShip      string `json:"StoreOldShip"`
+	StoreShipID       int64  `json:"StoreShipID"`
+	SellOldShip       string `json:"SellOldShip"`
+	SellShipID        int64  `json:"SellShipID"`
+	SellPrice         int64  `json:"SellPrice"`
+}
+
+// ShipyardSell is written when a ship is sold.
+type ShipyardSell struct {
+	*JournalEntry
+	MarketID          int64  `json:"MarketID"`
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	SellShipID        int64  `json:"SellShipID"`
+	ShipPrice         int64  `json:"ShipPrice"`
+	System            string `json:"System"`
+	ShipMarketID      int64  `json:"ShipMarketID"`
+}
+
+// ShipyardSwap is written when the player switches to another stored ship.
+type ShipyardSwap struct {
+	*JournalEntry
+	MarketID          int64  `json:"MarketID"`
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	ShipID            int64  `json:"ShipID"`
+	StoreOldShip      string `json:"StoreOldShip"`
+	StoreShipID       int64  `json:"StoreShipID"`
+	SellOldShip       string `json:"SellOldShip"`
+	SellShipID        int64  `json:"SellShipID"`
+}
+
+// ShipyardTransfer is written when a stored ship is sent to the current station.
+// TransferTime is in seconds.
+type ShipyardTransfer struct {
+	*JournalEntry
+	MarketID          int64   `json:"MarketID"`
+	ShipType          string  `json:"ShipType"`
+	ShipTypeLocalised string  `json:"ShipType_Localised"`
+	ShipID            int64   `json:"ShipID"`
+	System            string  `json:"System"`
+	ShipMarketID      int64   `json:"ShipMarketID"`
+	Distance          float64 `json:"Distance"`
+	TransferPrice     int64   `json:"TransferPrice"`
+	TransferTime      int64   `json:"TransferTime"`
+}
+
+// ShipyardNew is written after ShipyardBuy, once the new ship has its ID.
+type ShipyardNew struct {
+	*JournalEntry
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	NewShipID         int64  `json:"NewShipID"`
+}
+
+// StoredShip describes a ship that is not currently being flown.
+// The location fields are only set for ships stored at other stations.
+type StoredShip struct {
+	ShipID            int64  `json:"ShipID"`
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	Name              string `json:"Name"`
+	Value             int64  `json:"Value"`
+	Hot               bool   `json:"Hot"`
+	StarSystem        string `json:"StarSystem"`
+	ShipMarketID      int64  `json:"ShipMarketID"`
+	TransferPrice     int64  `json:"TransferPrice"`
+	TransferTime      int64  `json:"TransferTime"`
+	InTransit         bool   `json:"InTransit"`
+}
+
+// StoredShips is written when the shipyard is opened, and lists every ship
+// stored here and elsewhere.
+type StoredShips struct {
+	*JournalEntry
+	StationName string       `json:"StationName"`
+	MarketID    int64        `json:"MarketID"`
+	StarSystem  string       `json:"StarSystem"`
+	ShipsHere   []StoredShip `json:"ShipsHere"`
+	ShipsRemote []StoredShip `json:"ShipsRemote"`
+}
+
+// StoredModuleItem is a single module in storage, as listed by StoredModules.
+// The location and transfer fields are only set for modules stored at other stations.
+type StoredModuleItem struct {
+	Name                  string  `json:"Name"`
+	NameLocalised         string  `json:"Name_Localised"`
+	StorageSlot           int64   `json:"StorageSlot"`
+	StarSystem            string  `json:"StarSystem"`
+	MarketID              int64   `json:"MarketID"`
+	TransferCost          int64   `json:"TransferCost"`
+	TransferTime          int64   `json:"TransferTime"`
+	BuyPrice              int64   `json:"BuyPrice"`
+	Hot                   bool    `json:"Hot"`
+	InTransit             bool    `json:"InTransit"`
+	EngineerModifications string  `json:"EngineerModifications"`
+	Level                 int64   `json:"Level"`
+	Quality               float64 `json:"Quality"`
+}
+
+// StoredModulesEvent is written when outfitting is opened, and lists every
+// module in storage here and elsewhere.
+type StoredModulesEvent struct {
+	*JournalEntry
+	MarketID    int64              `json:"MarketID"`
+	StationName string             `json:"StationName"`
+	StarSystem  string             `json:"StarSystem"`
+	Items       []StoredModuleItem `json:"Items"`
+}
+
+// ModuleBuy is written when a module is bought. The module it replaces is
+// either stored (StoredItem) or sold (SellItem).
+type ModuleBuy struct {
+	*JournalEntry
+	MarketID            int64  `json:"MarketID"`
+	Slot                string `json:"Slot"`
+	BuyItem             string `json:"BuyItem"`
+	BuyItemLocalised    string `json:"BuyItem_Localised"`
+	BuyPrice            int64  `json:"BuyPrice"`
+	Ship                string `json:"Ship"`
+	ShipID              int64  `json:"ShipID"`
+	StoredItem          string `json:"StoredItem"`
+	StoredItemLocalised string `json:"StoredItem_Localised"`
+	SellItem            string `json:"SellItem"`
+	SellItemLocalised   string `json:"SellItem_Localised"`
+	SellPrice           int64  `json:"SellPrice"`
+}
+
+// ModuleSell is written when a fitted module is sold.
+type ModuleSell struct {
+	*JournalEntry
+	MarketID          int64  `json:"MarketID"`
+	Slot              string `json:"Slot"`
+	SellItem          string `json:"SellItem"`
+	SellItemLocalised string `json:"SellItem_Localised"`
+	SellPrice         int64  `json:"SellPrice"`
+	Ship              string `json:"Ship"`
+	ShipID            int64  `json:"ShipID"`
+}
+
+// ModuleSellRemote is written when a module in storage at another station is sold.
+type ModuleSellRemote struct {
+	*JournalEntry
+	StorageSlot       int64  `json:"StorageSlot"`
+	SellItem          string `json:"SellItem"`
+	SellItemLocalised string `json:"SellItem_Localised"`
+	ServerID          int64  `json:"ServerId"`
+	SellPrice         int64  `json:"SellPrice"`
+	Ship              string `json:"Ship"`
+	ShipID            int64  `json:"ShipID"`
+}
+
+// ModuleStore is written when a fitted module is moved into storage.
+type ModuleStore struct {
+	*JournalEntry
+	MarketID                 int64   `json:"MarketID"`
+	Slot                     string  `json:"Slot"`
+	StoredItem               string  `json:"StoredItem"`
+	StoredItemLocalised      string  `json:"StoredItem_Localised"`
+	Ship                     string  `json:"Ship"`
+	ShipID                   int64   `json:"ShipID"`
+	Hot                      bool    `json:"Hot"`
+	EngineerModifications    string  `json:"EngineerModifications"`
+	Level                    int64   `json:"Level"`
+	Quality                  float64 `json:"Quality"`
+	ReplacementItem          string  `json:"ReplacementItem"`
+	ReplacementItemLocalised string  `json:"ReplacementItem_Localised"`
+	Cost                     int64   `json:"Cost"`
+}
+
+// ModuleRetrieve is written when a stored module is fitted to the ship.
+type ModuleRetrieve struct {
+	*JournalEntry
+	MarketID               int64   `json:"MarketID"`
+	Slot                   string  `json:"Slot"`
+	RetrievedItem          string  `json:"RetrievedItem"`
+	RetrievedItemLocalised string  `json:"RetrievedItem_Localised"`
+	Ship                   string  `json:"Ship"`
+	ShipID                 int64   `json:"ShipID"`
+	Hot                    bool    `json:"Hot"`
+	EngineerModifications  string  `json:"EngineerModifications"`
+	Level                  int64   `json:"Level"`
+	Quality                float64 `json:"Quality"`
+	SwapOutItem            string  `json:"SwapOutItem"`
+	SwapOutItemLocalised   string  `json:"SwapOutItem_Localised"`
+	Cost                   int64   `json:"Cost"`
+}
+
+// ModuleSwap is written when two fitted modules swap slots.
+type ModuleSwap struct {
+	*JournalEntry
+	MarketID          int64  `json:"MarketID"`
+	FromSlot          string `json:"FromSlot"`
+	ToSlot            string `json:"ToSlot"`
+	FromItem          string `json:"FromItem"`
+	FromItemLocalised string `json:"FromItem_Localised"`
+	ToItem            string `json:"ToItem"`
+	ToItemLocalised   string `json:"ToItem_Localised"`
+	Ship              string `json:"Ship"`
+	ShipID            int64  `json:"ShipID"`
+}
+
+// FetchRemoteModule is written when a module stored at another station is
+// sent to the current station. TransferTime is in seconds.
+type FetchRemoteModule struct {
+	*JournalEntry
+	StorageSlot         int64  `json:"StorageSlot"`
+	StoredItem          string `json:"StoredItem"`
+	StoredItemLocalised string `json:"StoredItem_Localised"`
+	ServerID            int64  `json:"ServerId"`
+	TransferCost        int64  `json:"TransferCost"`
+	TransferTime        int64  `json:"TransferTime"`
+	Ship                string `json:"Ship"`
+	ShipID              int64  `json:"ShipID"`
+}
+
+// MassModuleStoreItem is a single module stored by MassModuleStore.
+type MassModuleStoreItem struct {
+	Slot                  string  `json:"Slot"`
+	Name                  string  `json:"Name"`
+	NameLocalised         string  `json:"Name_Localised"`
+	Hot                   bool    `json:"Hot"`
+	EngineerModifications string  `json:"EngineerModifications"`
+	Level                 int64   `json:"Level"`
+	Quality               float64 `json:"Quality"`
+}
+
+// MassModuleStore is written when several fitted modules are stored at once.
+type MassModuleStore struct {
+	*JournalEntry
+	MarketID int64                 `json:"MarketID"`
+	Ship     string                `json:"Ship"`
+	ShipID   int64                 `json:"ShipID"`
+	Items    []MassModuleStoreItem `json:"Items"`
+}
+
+// SetUserShipName is written when the player renames their ship.
+type SetUserShipName struct {
+	*JournalEntry
+	Ship         string `json:"Ship"`
+	ShipID       int64  `json:"ShipID"`
+	UserShipName string `json:"UserShipName"`
+	UserShipID   string `json:"UserShipId"`
+}
@@ -0,0 +1,121 @@
+package elite
+
+// FileHeader is the first event in every journal file.
+type FileHeader struct {
+	*JournalEntry
+	Part        int64  `json:"part"`
+	Language    string `json:"language"`
+	Odyssey     bool   `json:"Odyssey"`
+	GameVersion string `json:"gameversion"`
+	Build       string `json:"build"`
+}
+
+// Continued is the last event in a journal file that was split because it
+// grew too large. The journal continues in the file with the given part number.
+type Continued struct {
+	*JournalEntry
+	Part int64 `json:"Part"`
+}
+
+// Commander is written at startup, before the game is loaded.
+type Commander struct {
+	*JournalEntry
+	FID  string `json:"FID"`
+	Name string `json:"Name"`
+}
+
+// LoadGame is written when the game is loaded, and describes the commander and their ship.
+type LoadGame struct {
+	*JournalEntry
+	FID           string  `json:"FID"`
+	Commander     string  `json:"Commander"`
+	Horizons      bool    `json:"Horizons"`
+	Odyssey       bool    `json:"Odyssey"`
+	Ship          string  `json:"Ship"`
+	ShipLocalised string  `json:"Ship_Localised"`
+	ShipID        int64   `json:"ShipID"`
+	ShipName      string  `json:"ShipName"`
+	ShipIdent     string  `json:"ShipIdent"`
+	FuelLevel     float64 `json:"FuelLevel"`
+	FuelCapacity  float64 `json:"FuelCapacity"`
+	GameMode      string  `json:"GameMode"`
+	Group         string  `json:"Group"`
+	Credits       int64   `json:"Credits"`
+	Loan          int64   `json:"Loan"`
+	Language      string  `json:"language"`
+	GameVersion   string  `json:"gameversion"`
+	Build         string  `json:"build"`
+}
+
+// Material is a quantity of a single engineering material.
+type Material struct {
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Count         int64  `json:"Count"`
+}
+
+// Materials lists the commander's engineering materials at startup.
+type Materials struct {
+	*JournalEntry
+	Raw          []Material `json:"Raw"`
+	Manufactured []Material `json:"Manufactured"`
+	Encoded      []Material `json:"Encoded"`
+}
+
+// Rank contains the commander's ranks. Each value is an index into the
+// list of ranks for that category, starting at 0.
+type Rank struct {
+	*JournalEntry
+	Combat       int64 `json:"Combat"`
+	Trade        int64 `json:"Trade"`
+	Explore      int64 `json:"Explore"`
+	Soldier      int64 `json:"Soldier"`
+	Exobiologist int64 `json:"Exobiologist"`
+	Empire       int64 `json:"Empire"`
+	Federation   int64 `json:"Federation"`
+	CQC          int64 `json:"CQC"`
+}
+
+// Progress contains the commander's percentage progress towards the next rank in each category.
+type Progress struct {
+	*JournalEntry
+	Combat       int64 `json:"Combat"`
+	Trade        int64 `json:"Trade"`
+	Explore      int64 `json:"Explore"`
+	Soldier      int64 `json:"Soldier"`
+	Exobiologist int64 `json:"Exobiologist"`
+	Empire       int64 `json:"Empire"`
+	Federation   int64 `json:"Federation"`
+	CQC          int64 `json:"CQC"`
+}
+
+// Reputation contains the commander's reputation with each superpower,
+// from -100 to 100.
+type Reputation struct {
+	*JournalEntry
+	Empire      float64 `json:"Empire"`
+	Federation  float64 `json:"Federation"`
+	Alliance    float64 `json:"Alliance"`
+	Independent float64 `json:"Independent"`
+}
+
+// MissionSummary briefly describes a mission listed in the Missions event.
+type MissionSummary struct {
+	MissionID        int64  `json:"MissionID"`
+	Name             string `json:"Name"`
+	PassengerMission bool   `json:"PassengerMission"`
+	Expires          int64  `json:"Expires"`
+}
+
+// Missions lists the commander's missions at startup.
+type Missions struct {
+	*JournalEntry
+	Active   []MissionSummary `json:"Active"`
+	Failed   []MissionSummary `json:"Failed"`
+	Complete []MissionSummary `json:"Complete"`
+}
+
+// Shutdown is written when the game exits cleanly.
+type Shutdown struct {
+	*JournalEntry
+}
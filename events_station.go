@@ -0,0 +1,94 @@
+package elite
+
+// MarketBuy is written when commodities are bought at a market.
+type MarketBuy struct {
+	*JournalEntry
+	MarketID      int64  `json:"MarketID"`
+	Type          string `json:"Type"`
+	TypeLocalised string `json:"Type_Localised"`
+	Count         int64  `json:"Count"`
+	BuyPrice      int64  `json:"BuyPrice"`
+	TotalCost     int64  `json:"TotalCost"`
+}
+
+// MarketSell is written when commodities are sold at a market.
+type MarketSell struct {
+	*JournalEntry
+	MarketID      int64  `json:"MarketID"`
+	Type          string `json:"Type"`
+	TypeLocalised string `json:"Type_Localised"`
+	Count         int64  `json:"Count"`
+	SellPrice     int64  `json:"SellPrice"`
+	TotalSale     int64  `json:"TotalSale"`
+	AvgPricePaid  int64  `json:"AvgPricePaid"`
+	IllegalGoods  bool   `json:"IllegalGoods"`
+	StolenGoods   bool   `json:"StolenGoods"`
+	BlackMarket   bool   `json:"BlackMarket"`
+}
+
+// Refuel is written for both the RefuelAll and RefuelPartial events.
+type Refuel struct {
+	*JournalEntry
+	Cost   int64   `json:"Cost"`
+	Amount float64 `json:"Amount"`
+}
+
+// Repair is written for both the Repair and RepairAll events.
+// Item is empty for RepairAll.
+type Repair struct {
+	*JournalEntry
+	Item          string `json:"Item"`
+	ItemLocalised string `json:"Item_Localised"`
+	Cost          int64  `json:"Cost"`
+}
+
+// BuyAmmo is written when the ship's ammunition is restocked.
+type BuyAmmo struct {
+	*JournalEntry
+	Cost int64 `json:"Cost"`
+}
+
+// BuyDrones is written when limpets are bought.
+type BuyDrones struct {
+	*JournalEntry
+	Type      string `json:"Type"`
+	Count     int64  `json:"Count"`
+	BuyPrice  int64  `json:"BuyPrice"`
+	TotalCost int64  `json:"TotalCost"`
+}
+
+// SellDrones is written when limpets are sold.
+type SellDrones struct {
+	*JournalEntry
+	Type      string `json:"Type"`
+	Count     int64  `json:"Count"`
+	SellPrice int64  `json:"SellPrice"`
+	TotalSale int64  `json:"TotalSale"`
+}
+
+// PayFines is written for both the PayFines and PayBounties events.
+type PayFines struct {
+	*JournalEntry
+	Amount           int64   `json:"Amount"`
+	AllFines         bool    `json:"AllFines"`
+	Faction          string  `json:"Faction"`
+	ShipID           int64   `json:"ShipID"`
+	BrokerPercentage float64 `json:"BrokerPercentage"`
+}
+
+// VoucherFaction is the share of a redeemed voucher paid by a single faction.
+type VoucherFaction struct {
+	Faction string `json:"Faction"`
+	Amount  int64  `json:"Amount"`
+}
+
+// RedeemVoucher is written when bounty vouchers, combat bonds or other
+// vouchers are cashed in.
+type RedeemVoucher struct {
+	*JournalEntry
+	Type             string           `json:"Type"`
+	Amount           int64            `json:"Amount"`
+	Faction          string           `json:"Faction"`
+	Factions         []VoucherFaction `json:"Factions"`
+	BrokerPercentage float64          `json:"BrokerPercentage"`
+}
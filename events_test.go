@@ -0,0 +1,99 @@
+package elite_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestParseEvent(t *testing.T) {
+	event, err := elite.ParseEvent([]byte(`{ "timestamp":"2020-01-18T03:20:00Z", "event":"FSDJump", "StarSystem":"Alpha Centauri", "SystemAddress":1458376315610, "StarPos":[3.03125,-0.09375,3.15625], "JumpDist":4.377, "FuelUsed":0.12, "FuelLevel":31.88, "Factions":[ { "Name":"Hutton Orbital Truckers", "Influence":0.6 } ] }`))
+	if err != nil {
+		fmt.Println("Couldn't parse event: " + err.Error())
+		t.FailNow()
+	}
+
+	jump, ok := event.(*elite.FSDJump)
+	if !ok {
+		fmt.Printf("Incorrect event type: Expecting *elite.FSDJump, got %T\n", event)
+		t.FailNow()
+	}
+	if jump.StarSystem != "Alpha Centauri" || jump.JumpDist != 4.377 || len(jump.Factions) != 1 {
+		fmt.Println("FSDJump fields were parsed incorrectly")
+		t.FailNow()
+	}
+	if jump.EventTime().Hour() != 3 || jump.EventTime().Minute() != 20 {
+		fmt.Println("Incorrect event time: " + jump.EventTime().String())
+		t.FailNow()
+	}
+}
+
+func TestParseEventFileheader(t *testing.T) {
+	event, err := elite.ParseEvent([]byte(`{ "timestamp":"2020-01-18T03:18:00Z", "event":"Fileheader", "part":1, "language":"English\\UK", "Odyssey":true, "gameversion":"4.0.0.1450", "build":"r289925/r0 " }`))
+	if err != nil {
+		fmt.Println("Couldn't parse event: " + err.Error())
+		t.FailNow()
+	}
+	header, ok := event.(*elite.FileHeader)
+	if !ok || header.Part != 1 || header.GameVersion != "4.0.0.1450" {
+		fmt.Printf("Incorrect event type: Expecting *elite.FileHeader, got %T\n", event)
+		t.FailNow()
+	}
+}
+
+func TestParseEventUnknown(t *testing.T) {
+	line := `{ "timestamp":"2020-01-18T03:20:00Z", "event":"SomeFutureEvent", "Value":42 }`
+	event, err := elite.ParseEvent([]byte(line))
+	if err != nil {
+		fmt.Println("Couldn't parse event: " + err.Error())
+		t.FailNow()
+	}
+
+	unknown, ok := event.(*elite.UnknownEvent)
+	if !ok {
+		fmt.Printf("Incorrect event type: Expecting *elite.UnknownEvent, got %T\n", event)
+		t.FailNow()
+	}
+	if unknown.EventName() != "SomeFutureEvent" || string(unknown.Raw) != line {
+		fmt.Println("Unknown event did not preserve the original line")
+		t.FailNow()
+	}
+
+	var fields struct{ Value int }
+	if err := json.Unmarshal(unknown.Raw, &fields); err != nil || fields.Value != 42 {
+		fmt.Println("Couldn't decode the raw JSON of an unknown event")
+		t.FailNow()
+	}
+}
+
+type customEvent struct {
+	*elite.JournalEntry
+	Value int `json:"Value"`
+}
+
+func TestRegisterEvent(t *testing.T) {
+	elite.RegisterEvent("CustomTestEvent", func() elite.Event { return &customEvent{} })
+
+	event, err := elite.ParseEvent([]byte(`{ "timestamp":"2020-01-18T03:20:00Z", "event":"CustomTestEvent", "Value":7 }`))
+	if err != nil {
+		fmt.Println("Couldn't parse event: " + err.Error())
+		t.FailNow()
+	}
+	if custom, ok := event.(*customEvent); !ok || custom.Value != 7 {
+		fmt.Println("Registered event type was not used")
+		t.FailNow()
+	}
+}
+
+func TestParseEventMalformed(t *testing.T) {
+	if _, err := elite.ParseEvent([]byte(`{ "timestamp":"2020-01-18T03:20:00Z", "event":"FSDJump", `)); err == nil {
+		fmt.Println("Expected an error for a truncated line")
+		t.FailNow()
+	}
+	if _, err := elite.ParseEvent([]byte(`{ "timestamp":"2020-01-18T03:20:00Z" }`)); err == nil {
+		fmt.Println("Expected an error for a line without an event name")
+		t.FailNow()
+	}
+}
@@ -0,0 +1,286 @@
+package elite
+
+// Faction describes a minor faction present in a star system.
+type Faction struct {
+	Name               string  `json:"Name"`
+	FactionState       string  `json:"FactionState"`
+	Government         string  `json:"Government"`
+	Influence          float64 `json:"Influence"`
+	Allegiance         string  `json:"Allegiance"`
+	Happiness          string  `json:"Happiness"`
+	HappinessLocalised string  `json:"Happiness_Localised"`
+	MyReputation       float64 `json:"MyReputation"`
+}
+
+// SystemFaction identifies the controlling faction of a star system.
+type SystemFaction struct {
+	Name         string `json:"Name"`
+	FactionState string `json:"FactionState"`
+}
+
+// Location is written at startup, and describes where the player is.
+type Location struct {
+	*JournalEntry
+	StarSystem                   string        `json:"StarSystem"`
+	SystemAddress                int64         `json:"SystemAddress"`
+	StarPos                      [3]float64    `json:"StarPos"`
+	Body                         string        `json:"Body"`
+	BodyID                       int64         `json:"BodyID"`
+	BodyType                     string        `json:"BodyType"`
+	DistFromStarLS               float64       `json:"DistFromStarLS"`
+	Docked                       bool          `json:"Docked"`
+	StationName                  string        `json:"StationName"`
+	StationType                  string        `json:"StationType"`
+	MarketID                     int64         `json:"MarketID"`
+	Latitude                     float64       `json:"Latitude"`
+	Longitude                    float64       `json:"Longitude"`
+	Taxi                         bool          `json:"Taxi"`
+	Multicrew                    bool          `json:"Multicrew"`
+	InSRV                        bool          `json:"InSRV"`
+	OnFoot                       bool          `json:"OnFoot"`
+	SystemAllegiance             string        `json:"SystemAllegiance"`
+	SystemEconomy                string        `json:"SystemEconomy"`
+	SystemEconomyLocalised       string        `json:"SystemEconomy_Localised"`
+	SystemSecondEconomy          string        `json:"SystemSecondEconomy"`
+	SystemSecondEconomyLocalised string        `json:"SystemSecondEconomy_Localised"`
+	SystemGovernment             string        `json:"SystemGovernment"`
+	SystemGovernmentLocalised    string        `json:"SystemGovernment_Localised"`
+	SystemSecurity               string        `json:"SystemSecurity"`
+	SystemSecurityLocalised      string        `json:"SystemSecurity_Localised"`
+	Population                   int64         `json:"Population"`
+	Factions                     []Faction     `json:"Factions"`
+	SystemFaction                SystemFaction `json:"SystemFaction"`
+}
+
+// FSDJump is written when the ship arrives in a new star system.
+type FSDJump struct {
+	*JournalEntry
+	StarSystem                   string        `json:"StarSystem"`
+	SystemAddress                int64         `json:"SystemAddress"`
+	StarPos                      [3]float64    `json:"StarPos"`
+	Body                         string        `json:"Body"`
+	BodyID                       int64         `json:"BodyID"`
+	BodyType                     string        `json:"BodyType"`
+	JumpDist                     float64       `json:"JumpDist"`
+	FuelUsed                     float64       `json:"FuelUsed"`
+	FuelLevel                    float64       `json:"FuelLevel"`
+	BoostUsed                    int64         `json:"BoostUsed"`
+	Taxi                         bool          `json:"Taxi"`
+	Multicrew                    bool          `json:"Multicrew"`
+	SystemAllegiance             string        `json:"SystemAllegiance"`
+	SystemEconomy                string        `json:"SystemEconomy"`
+	SystemEconomyLocalised       string        `json:"SystemEconomy_Localised"`
+	SystemSecondEconomy          string        `json:"SystemSecondEconomy"`
+	SystemSecondEconomyLocalised string        `json:"SystemSecondEconomy_Localised"`
+	SystemGovernment             string        `json:"SystemGovernment"`
+	SystemGovernmentLocalised    string        `json:"SystemGovernment_Localised"`
+	SystemSecurity               string        `json:"SystemSecurity"`
+	SystemSecurityLocalised      string        `json:"SystemSecurity_Localised"`
+	Population                   int64         `json:"Population"`
+	Factions                     []Faction     `json:"Factions"`
+	SystemFaction                SystemFaction `json:"SystemFaction"`
+}
+
+// FSDTarget is written when a star system is selected as the next jump target.
+type FSDTarget struct {
+	*JournalEntry
+	Name                  string `json:"Name"`
+	SystemAddress         int64  `json:"SystemAddress"`
+	StarClass             string `json:"StarClass"`
+	RemainingJumpsInRoute int64  `json:"RemainingJumpsInRoute"`
+}
+
+// StartJump is written when the FSD starts charging for a jump.
+// JumpType is either "Hyperspace" or "Supercruise".
+type StartJump struct {
+	*JournalEntry
+	JumpType      string `json:"JumpType"`
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	StarClass     string `json:"StarClass"`
+	Taxi          bool   `json:"Taxi"`
+}
+
+// SupercruiseEntry is written when the ship enters supercruise.
+type SupercruiseEntry struct {
+	*JournalEntry
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Taxi          bool   `json:"Taxi"`
+	Multicrew     bool   `json:"Multicrew"`
+}
+
+// SupercruiseExit is written when the ship drops out of supercruise.
+type SupercruiseExit struct {
+	*JournalEntry
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Body          string `json:"Body"`
+	BodyID        int64  `json:"BodyID"`
+	BodyType      string `json:"BodyType"`
+	Taxi          bool   `json:"Taxi"`
+	Multicrew     bool   `json:"Multicrew"`
+}
+
+// ApproachBody is written when the ship enters orbital cruise around a planet.
+type ApproachBody struct {
+	*JournalEntry
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Body          string `json:"Body"`
+	BodyID        int64  `json:"BodyID"`
+}
+
+// LeaveBody is written when the ship leaves orbital cruise around a planet.
+type LeaveBody struct {
+	*JournalEntry
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Body          string `json:"Body"`
+	BodyID        int64  `json:"BodyID"`
+}
+
+// Touchdown is written when the ship lands on a planet surface.
+type Touchdown struct {
+	*JournalEntry
+	PlayerControlled   bool    `json:"PlayerControlled"`
+	Latitude           float64 `json:"Latitude"`
+	Longitude          float64 `json:"Longitude"`
+	NearestDestination string  `json:"NearestDestination"`
+	StarSystem         string  `json:"StarSystem"`
+	SystemAddress      int64   `json:"SystemAddress"`
+	Body               string  `json:"Body"`
+	BodyID             int64   `json:"BodyID"`
+	OnStation          bool    `json:"OnStation"`
+	OnPlanet           bool    `json:"OnPlanet"`
+}
+
+// Liftoff is written when the ship takes off from a planet surface.
+type Liftoff struct {
+	*JournalEntry
+	PlayerControlled   bool    `json:"PlayerControlled"`
+	Latitude           float64 `json:"Latitude"`
+	Longitude          float64 `json:"Longitude"`
+	NearestDestination string  `json:"NearestDestination"`
+	StarSystem         string  `json:"StarSystem"`
+	SystemAddress      int64   `json:"SystemAddress"`
+	Body               string  `json:"Body"`
+	BodyID             int64   `json:"BodyID"`
+	OnStation          bool    `json:"OnStation"`
+	OnPlanet           bool    `json:"OnPlanet"`
+}
+
+// DockingRequested is written when the player requests docking at a station.
+type DockingRequested struct {
+	*JournalEntry
+	StationName string `json:"StationName"`
+	StationType string `json:"StationType"`
+	MarketID    int64  `json:"MarketID"`
+}
+
+// DockingGranted is written when a station grants a docking request.
+type DockingGranted struct {
+	*JournalEntry
+	StationName string `json:"StationName"`
+	StationType string `json:"StationType"`
+	MarketID    int64  `json:"MarketID"`
+	LandingPad  int64  `json:"LandingPad"`
+}
+
+// DockingDenied is written when a station denies a docking request.
+type DockingDenied struct {
+	*JournalEntry
+	StationName string `json:"StationName"`
+	StationType string `json:"StationType"`
+	MarketID    int64  `json:"MarketID"`
+	Reason      string `json:"Reason"`
+}
+
+// StationEconomy is one of the economies of a station, with its proportion.
+type StationEconomy struct {
+	Name          string  `json:"Name"`
+	NameLocalised string  `json:"Name_Localised"`
+	Proportion    float64 `json:"Proportion"`
+}
+
+// Docked is written when the ship docks at a station or carrier.
+type Docked struct {
+	*JournalEntry
+	StationName                string           `json:"StationName"`
+	StationType                string           `json:"StationType"`
+	StarSystem                 string           `json:"StarSystem"`
+	SystemAddress              int64            `json:"SystemAddress"`
+	MarketID                   int64            `json:"MarketID"`
+	StationFaction             SystemFaction    `json:"StationFaction"`
+	StationGovernment          string           `json:"StationGovernment"`
+	StationGovernmentLocalised string           `json:"StationGovernment_Localised"`
+	StationAllegiance          string           `json:"StationAllegiance"`
+	StationServices            []string         `json:"StationServices"`
+	StationEconomy             string           `json:"StationEconomy"`
+	StationEconomyLocalised    string           `json:"StationEconomy_Localised"`
+	StationEconomies           []StationEconomy `json:"StationEconomies"`
+	DistFromStarLS             float64          `json:"DistFromStarLS"`
+	Taxi                       bool             `json:"Taxi"`
+	Multicrew                  bool             `json:"Multicrew"`
+	Wanted                     bool             `json:"Wanted"`
+	ActiveFine                 bool             `json:"ActiveFine"`
+}
+
+// Undocked is written when the ship leaves a station or carrier.
+type Undocked struct {
+	*JournalEntry
+	StationName string `json:"StationName"`
+	StationType string `json:"StationType"`
+	MarketID    int64  `json:"MarketID"`
+	Taxi        bool   `json:"Taxi"`
+	Multicrew   bool   `json:"Multicrew"`
+}
+
+// Embark is written when the player boards a ship, SRV or taxi while on foot.
+type Embark struct {
+	*JournalEntry
+	SRV           bool   `json:"SRV"`
+	Taxi          bool   `json:"Taxi"`
+	Multicrew     bool   `json:"Multicrew"`
+	ID            int64  `json:"ID"`
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Body          string `json:"Body"`
+	BodyID        int64  `json:"BodyID"`
+	OnStation     bool   `json:"OnStation"`
+	OnPlanet      bool   `json:"OnPlanet"`
+	StationName   string `json:"StationName"`
+	StationType   string `json:"StationType"`
+	MarketID      int64  `json:"MarketID"`
+}
+
+// Disembark is written when the player leaves a ship, SRV or taxi on foot.
+type Disembark struct {
+	*JournalEntry
+	SRV           bool   `json:"SRV"`
+	Taxi          bool   `json:"Taxi"`
+	Multicrew     bool   `json:"Multicrew"`
+	ID            int64  `json:"ID"`
+	StarSystem    string `json:"StarSystem"`
+	SystemAddress int64  `json:"SystemAddress"`
+	Body          string `json:"Body"`
+	BodyID        int64  `json:"BodyID"`
+	OnStation     bool   `json:"OnStation"`
+	OnPlanet      bool   `json:"OnPlanet"`
+	StationName   string `json:"StationName"`
+	StationType   string `json:"StationType"`
+	MarketID      int64  `json:"MarketID"`
+}
+
+// FuelScoop is written when the ship scoops fuel from a star.
+type FuelScoop struct {
+	*JournalEntry
+	Scooped float64 `json:"Scooped"`
+	Total   float64 `json:"Total"`
+}
+
+// JetConeBoost is written when the ship is boosted by a neutron star or white dwarf jet cone.
+type JetConeBoost struct {
+	*JournalEntry
+	BoostValue float64 `json:"BoostValue"`
+}
@@ -0,0 +1,137 @@
+package elite
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	coriolisImportURL = "https://coriolis.io/import?data="
+	edsyImportURL     = "https://edsy.org/#/I="
+)
+
+// SLEFHeader identifies the application that wrote a build in the Ship
+// Loadout Exchange Format.
+type SLEFHeader struct {
+	AppName    string `json:"appName"`
+	AppVersion string `json:"appVersion,omitempty"`
+	AppURL     string `json:"appURL,omitempty"`
+}
+
+// slefEntry is a single build in the Ship Loadout Exchange Format. The data
+// is the ship's Loadout journal event.
+type slefEntry struct {
+	Header SLEFHeader      `json:"header"`
+	Data   json.RawMessage `json:"data"`
+}
+
+var defaultSLEFHeader = SLEFHeader{AppName: "elite", AppURL: "https://github.com/BenJuan26/elite"}
+
+// ExportSLEF writes the loadout in the Ship Loadout Exchange Format, which
+// can be imported by EDSY, Coriolis and other shipbuilders.
+func (l *Loadout) ExportSLEF() ([]byte, error) {
+	return ExportSLEF(defaultSLEFHeader, l)
+}
+
+// ExportSLEF writes one or more loadouts in the Ship Loadout Exchange
+// Format, with the given header on each of them.
+func ExportSLEF(header SLEFHeader, loadouts ...*Loadout) ([]byte, error) {
+	entries := make([]slefEntry, 0, len(loadouts))
+	for _, l := range loadouts {
+		data, err := l.journalJSON()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, slefEntry{Header: header, Data: data})
+	}
+	return json.Marshal(entries)
+}
+
+// ImportSLEF reads the loadouts from a build in the Ship Loadout Exchange
+// Format. Both a list of builds and a single build are accepted.
+func ImportSLEF(content []byte) ([]*Loadout, error) {
+	var entries []slefEntry
+	content = bytes.TrimSpace(content)
+	if bytes.HasPrefix(content, []byte("{")) {
+		var entry slefEntry
+		if err := json.Unmarshal(content, &entry); err != nil {
+			return nil, fmt.Errorf("Couldn't parse SLEF: %w", err)
+		}
+		entries = append(entries, entry)
+	} else if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("Couldn't parse SLEF: %w", err)
+	}
+
+	loadouts := make([]*Loadout, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Data) == 0 {
+			return nil, errors.New("Couldn't parse SLEF: build has no data")
+		}
+		l := &Loadout{}
+		if err := decodeEvent(entry.Data, l); err != nil {
+			return nil, fmt.Errorf("Couldn't parse SLEF: %w", err)
+		}
+		if l.JournalEntry == nil {
+			l.JournalEntry = &JournalEntry{}
+		}
+		if l.Event == "" {
+			l.Event = "Loadout"
+		}
+		loadouts = append(loadouts, l)
+	}
+	return loadouts, nil
+}
+
+// CoriolisURL returns a link that opens the loadout in Coriolis.
+func (l *Loadout) CoriolisURL() (string, error) {
+	data, err := l.encodeBuild()
+	if err != nil {
+		return "", err
+	}
+	return coriolisImportURL + data, nil
+}
+
+// EDSYURL returns a link that opens the loadout in EDSY.
+func (l *Loadout) EDSYURL() (string, error) {
+	data, err := l.encodeBuild()
+	if err != nil {
+		return "", err
+	}
+	return edsyImportURL + data, nil
+}
+
+// journalJSON writes the loadout as the game writes its Loadout journal event.
+func (l *Loadout) journalJSON() ([]byte, error) {
+	out := *l
+	entry := JournalEntry{Event: "Loadout"}
+	if l.JournalEntry != nil {
+		entry.Timestamp = l.Timestamp
+	}
+	out.JournalEntry = &entry
+	return json.Marshal(&out)
+}
+
+// encodeBuild gzips the loadout's journal event and encodes it for use in a
+// URL, as the shipbuilders expect.
+func (l *Loadout) encodeBuild() (string, error) {
+	data, err := l.journalJSON()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	encoded := base64.URLEncoding.EncodeToString(buf.Bytes())
+	return strings.ReplaceAll(encoded, "=", "%3D"), nil
+}
@@ -0,0 +1,113 @@
+package elite_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestLoadoutSLEFRoundTrip(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	content, err := l.ExportSLEF()
+	if err != nil {
+		fmt.Println("Couldn't export SLEF: " + err.Error())
+		t.FailNow()
+	}
+
+	var entries []struct {
+		Header map[string]string      `json:"header"`
+		Data   map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(content, &entries); err != nil || len(entries) != 1 {
+		fmt.Printf("Incorrect SLEF: %s (%v)\n", content, err)
+		t.FailNow()
+	}
+	if entries[0].Header["appName"] != "elite" || entries[0].Data["event"] != "Loadout" || entries[0].Data["Ship"] != "krait_light" {
+		fmt.Printf("Incorrect SLEF entry: %+v\n", entries[0])
+		t.FailNow()
+	}
+
+	// The test ship has no weapons, so no module should have ammunition written.
+	if strings.Contains(string(content), "AmmoInClip") {
+		fmt.Printf("Unexpected ammunition in SLEF: %s\n", content)
+		t.FailNow()
+	}
+
+	loadouts, err := elite.ImportSLEF(content)
+	if err != nil || len(loadouts) != 1 {
+		fmt.Printf("Couldn't import SLEF: %v\n", err)
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(loadouts[0], l) {
+		fmt.Printf("Loadout changed in round trip:\n%+v\n%+v\n", loadouts[0], l)
+		t.FailNow()
+	}
+
+	// A single build without a timestamp is accepted too.
+	single := `{"header":{"appName":"EDSY"},"data":{"Ship":"sidewinder","Modules":[{"Slot":"Armour","Item":"sidewinder_armour_grade1","On":true}]}}`
+	loadouts, err = elite.ImportSLEF([]byte(single))
+	if err != nil || len(loadouts) != 1 || loadouts[0].Ship != "sidewinder" || loadouts[0].Event != "Loadout" || len(loadouts[0].Modules) != 1 {
+		fmt.Printf("Couldn't import single SLEF build: %v\n", err)
+		t.FailNow()
+	}
+
+	if _, err := elite.ImportSLEF([]byte(`[{"header":{"appName":"EDSY"}}]`)); err == nil {
+		fmt.Println("Expected an error for a build without data")
+		t.FailNow()
+	}
+}
+
+func TestLoadoutShipbuilderURLs(t *testing.T) {
+	l, err := elite.GetLoadoutFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get loadout: " + err.Error())
+		t.FailNow()
+	}
+
+	coriolis, err := l.CoriolisURL()
+	if err != nil || !strings.HasPrefix(coriolis, "https://coriolis.io/import?data=") {
+		fmt.Printf("Incorrect Coriolis URL: %s (%v)\n", coriolis, err)
+		t.FailNow()
+	}
+	edsy, err := l.EDSYURL()
+	if err != nil || !strings.HasPrefix(edsy, "https://edsy.org/#/I=") {
+		fmt.Printf("Incorrect EDSY URL: %s (%v)\n", edsy, err)
+		t.FailNow()
+	}
+
+	data := strings.ReplaceAll(strings.TrimPrefix(coriolis, "https://coriolis.io/import?data="), "%3D", "=")
+	compressed, err := base64.URLEncoding.DecodeString(data)
+	if err != nil {
+		fmt.Println("Couldn't decode Coriolis data: " + err.Error())
+		t.FailNow()
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		fmt.Println("Couldn't decompress Coriolis data: " + err.Error())
+		t.FailNow()
+	}
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		fmt.Println("Couldn't decompress Coriolis data: " + err.Error())
+		t.FailNow()
+	}
+
+	decoded := &elite.Loadout{}
+	if err := json.Unmarshal(content, decoded); err != nil || !reflect.DeepEqual(decoded, l) {
+		fmt.Printf("Coriolis data doesn't match the loadout: %s (%v)\n", content, err)
+		t.FailNow()
+	}
+}
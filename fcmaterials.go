@@ -0,0 +1,63 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// FCMaterial is a micro-resource traded at a fleet carrier's bar.
+type FCMaterial struct {
+	ID            int64  `json:"id"`
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised"`
+	Price         int64  `json:"Price"`
+	Stock         int64  `json:"Stock"`
+	Demand        int64  `json:"Demand"`
+}
+
+// FCMaterials lists the micro-resources bought and sold at a fleet carrier's bar.
+// It is written when the player opens the bartender; the journal event only
+// identifies the carrier, while FCMaterials.json also lists the Items.
+type FCMaterials struct {
+	*JournalEntry
+	MarketID    int64        `json:"MarketID"`
+	CarrierName string       `json:"CarrierName"`
+	CarrierID   string       `json:"CarrierID"`
+	Items       []FCMaterial `json:"Items,omitempty"`
+}
+
+// GetFCMaterials reads the micro-resources traded at the fleet carrier last visited from FCMaterials.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetFCMaterialsFromPath.
+func GetFCMaterials() (*FCMaterials, error) {
+	return GetFCMaterialsFromPath(defaultLogPath())
+}
+
+// GetFCMaterialsFromPath reads the micro-resources traded at the fleet carrier last visited from FCMaterials.json at the specified log path.
+func GetFCMaterialsFromPath(logPath string) (*FCMaterials, error) {
+	return NewClient(WithLogPath(logPath)).GetFCMaterials()
+}
+
+// GetFCMaterials reads the micro-resources traded at the fleet carrier last visited from FCMaterials.json.
+func (c *Client) GetFCMaterials() (*FCMaterials, error) {
+	var materials *FCMaterials
+	err := c.readCompanionFile("FCMaterials.json", func(content []byte) (err error) {
+		materials, err = GetFCMaterialsFromBytes(content)
+		return err
+	})
+	return materials, err
+}
+
+// GetFCMaterialsFromBytes reads the micro-resources traded at the fleet carrier last visited from the string contained in the byte array.
+func GetFCMaterialsFromBytes(content []byte) (*FCMaterials, error) {
+	materials := &FCMaterials{}
+	if err := json.Unmarshal(content, materials); err != nil {
+		return nil, errors.New("Couldn't unmarshal FCMaterials.json file: " + err.Error())
+	}
+
+	return materials, nil
+}
@@ -0,0 +1,53 @@
+package elite
+
+import (
+	"errors"
+	"io/fs"
+	"time"
+)
+
+// fileWatch detects rewrites of a file by polling its modification time and size.
+type fileWatch struct {
+	fsys    fs.FS
+	name    string
+	modTime time.Time
+	size    int64
+	pending fs.FileInfo
+}
+
+// poll returns the contents of the file if it has changed since the last
+// accepted read, or nil if it hasn't changed or doesn't exist yet.
+// The caller should call accept once it has successfully parsed the
+// contents; until then, the same rewrite will be returned by every poll.
+// This lets a caller skip over a file that the game is still writing.
+func (w *fileWatch) poll() ([]byte, error) {
+	info, err := fs.Stat(w.fsys, w.name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+		return nil, nil
+	}
+
+	content, err := fs.ReadFile(w.fsys, w.name)
+	if err != nil || len(content) == 0 {
+		// The game may have the file open for writing; try again next poll.
+		return nil, nil
+	}
+
+	w.pending = info
+	return content, nil
+}
+
+// accept records the last rewrite returned by poll as handled.
+func (w *fileWatch) accept() {
+	if w.pending == nil {
+		return
+	}
+	w.modTime = w.pending.ModTime()
+	w.size = w.pending.Size()
+	w.pending = nil
+}
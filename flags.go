@@ -1,9 +1,15 @@
 package elite
 
 import (
+	"reflect"
+
 	"github.com/BenJuan26/elite/flags"
+	"github.com/BenJuan26/elite/flags2"
 )
 
+// maxPips is the most pips that can be assigned to one of systems, engines or weapons.
+const maxPips = 4
+
 // StatusFlags contains boolean flags describing the player and ship.
 type StatusFlags struct {
 	Docked                    bool
@@ -38,40 +44,112 @@ type StatusFlags struct {
 	AltitudeFromAverageRadius bool
 	FSDJump                   bool
 	SRVHighBeam               bool
+
+	// The remaining flags are derived from GuiFocus and Pips rather than the Flags bitmask.
+
+	MapOpen             bool
+	GalaxyMapOpen       bool
+	SystemMapOpen       bool
+	PanelFocused        bool
+	StationServicesOpen bool
+	FSSMode             bool
+	SAAMode             bool
+	CodexOpen           bool
+	FullSystemsPips     bool
+	FullEnginesPips     bool
+	FullWeaponsPips     bool
 }
 
-// ExpandFlags parses the RawFlags and sets the Flags values accordingly.
+// StatusFlags2 contains the boolean flags added in Odyssey, mostly describing the player on foot.
+type StatusFlags2 struct {
+	OnFoot                bool
+	InTaxi                bool
+	InMulticrew           bool
+	OnFootInStation       bool
+	OnFootOnPlanet        bool
+	AimDownSight          bool
+	LowOxygen             bool
+	LowHealth             bool
+	Cold                  bool
+	Hot                   bool
+	VeryCold              bool
+	VeryHot               bool
+	GlideMode             bool
+	OnFootInHangar        bool
+	OnFootSocialSpace     bool
+	OnFootExterior        bool
+	BreathableAtmosphere  bool
+	TelepresenceMulticrew bool
+	PhysicalMulticrew     bool
+	FSDHyperdriveCharging bool
+}
+
+// ExpandFlags parses the RawFlags and RawFlags2 and sets the Flags and Flags2 values accordingly.
+// The Flags derived from GuiFocus and Pips are set as well.
 func (status *Status) ExpandFlags() {
-	status.Flags.Docked = status.RawFlags&flags.Docked != 0
-	status.Flags.Landed = status.RawFlags&flags.Landed != 0
-	status.Flags.LandingGearDown = status.RawFlags&flags.LandingGearDown != 0
-	status.Flags.ShieldsUp = status.RawFlags&flags.ShieldsUp != 0
-	status.Flags.Supercruise = status.RawFlags&flags.Supercruise != 0
-	status.Flags.FlightAssistOff = status.RawFlags&flags.FlightAssistOff != 0
-	status.Flags.HardpointsDeployed = status.RawFlags&flags.HardpointsDeployed != 0
-	status.Flags.InWing = status.RawFlags&flags.InWing != 0
-	status.Flags.LightsOn = status.RawFlags&flags.LightsOn != 0
-	status.Flags.CargoScoopDeployed = status.RawFlags&flags.CargoScoopDeployed != 0
-	status.Flags.SilentRunning = status.RawFlags&flags.SilentRunning != 0
-	status.Flags.ScoopingFuel = status.RawFlags&flags.ScoopingFuel != 0
-	status.Flags.SRVHandbrake = status.RawFlags&flags.SRVHandbrake != 0
-	status.Flags.SRVTurret = status.RawFlags&flags.SRVTurret != 0
-	status.Flags.SRVUnderShip = status.RawFlags&flags.SRVUnderShip != 0
-	status.Flags.SRVDriveAssist = status.RawFlags&flags.SRVDriveAssist != 0
-	status.Flags.FSDMassLocked = status.RawFlags&flags.FSDMassLocked != 0
-	status.Flags.FSDCharging = status.RawFlags&flags.FSDCharging != 0
-	status.Flags.FSDCooldown = status.RawFlags&flags.FSDCooldown != 0
-	status.Flags.LowFuel = status.RawFlags&flags.LowFuel != 0
-	status.Flags.Overheating = status.RawFlags&flags.Overheating != 0
-	status.Flags.HasLatLong = status.RawFlags&flags.HasLatLong != 0
-	status.Flags.IsInDanger = status.RawFlags&flags.IsInDanger != 0
-	status.Flags.BeingInterdicted = status.RawFlags&flags.BeingInterdicted != 0
-	status.Flags.InMainShip = status.RawFlags&flags.InMainShip != 0
-	status.Flags.InFighter = status.RawFlags&flags.InFighter != 0
-	status.Flags.InSRV = status.RawFlags&flags.InSRV != 0
-	status.Flags.InAnalysisMode = status.RawFlags&flags.InAnalysisMode != 0
-	status.Flags.NightVision = status.RawFlags&flags.NightVision != 0
-	status.Flags.AltitudeFromAverageRadius = status.RawFlags&flags.AltitudeFromAverageRadius != 0
-	status.Flags.FSDJump = status.RawFlags&flags.FSDJump != 0
-	status.Flags.SRVHighBeam = status.RawFlags&flags.SRVHighBeam != 0
+	status.Flags = StatusFlags{}
+	setFlagFields(&status.Flags, flags.Flags(status.RawFlags).List())
+	status.Flags2 = StatusFlags2{}
+	setFlagFields(&status.Flags2, flags2.Flags(status.RawFlags2).List())
+
+	status.Flags.MapOpen = status.GuiFocus.IsMapOpen()
+	status.Flags.GalaxyMapOpen = status.GuiFocus == flags.GuiFocusGalaxyMap
+	status.Flags.SystemMapOpen = status.GuiFocus == flags.GuiFocusSystemMap
+	status.Flags.PanelFocused = status.GuiFocus.IsPanelFocused()
+	status.Flags.StationServicesOpen = status.GuiFocus == flags.GuiFocusStationServices
+	status.Flags.FSSMode = status.GuiFocus == flags.GuiFocusFSSMode
+	status.Flags.SAAMode = status.GuiFocus == flags.GuiFocusSAAMode
+	status.Flags.CodexOpen = status.GuiFocus == flags.GuiFocusCodex
+	status.Flags.FullSystemsPips = status.Pips.Sys() == maxPips
+	status.Flags.FullEnginesPips = status.Pips.Eng() == maxPips
+	status.Flags.FullWeaponsPips = status.Pips.Wep() == maxPips
+}
+
+// CollapseFlags sets the RawFlags and RawFlags2 from the Flags and Flags2 values.
+// It is the reverse of ExpandFlags, for building a Status to write out as Status.json.
+func (status *Status) CollapseFlags() {
+	status.RawFlags = status.Flags.Raw()
+	status.RawFlags2 = status.Flags2.Raw()
+}
+
+// Raw returns the Flags bitmask with the flags that are set in statusFlags.
+// The flags derived from GuiFocus and Pips have no bit and are ignored.
+func (statusFlags StatusFlags) Raw() uint32 {
+	return rawFlags(statusFlags, func(name string) (uint32, bool) {
+		flag, ok := flags.Lookup(name)
+		return uint32(flag), ok
+	})
+}
+
+// Raw returns the Flags2 bitmask with the flags that are set in statusFlags.
+func (statusFlags StatusFlags2) Raw() uint32 {
+	return rawFlags(statusFlags, func(name string) (uint32, bool) {
+		flag, ok := flags2.Lookup(name)
+		return uint32(flag), ok
+	})
+}
+
+// setFlagFields sets the bool fields of the struct pointed to by statusFlags
+// that are named in names.
+func setFlagFields(statusFlags interface{}, names []string) {
+	fields := reflect.ValueOf(statusFlags).Elem()
+	for _, name := range names {
+		fields.FieldByName(name).SetBool(true)
+	}
+}
+
+// rawFlags returns the bitmask of the bool fields that are set in
+// statusFlags, using lookup to find the bit of each field by name.
+func rawFlags(statusFlags interface{}, lookup func(name string) (uint32, bool)) uint32 {
+	var raw uint32
+	fields := reflect.ValueOf(statusFlags)
+	for i := 0; i < fields.NumField(); i++ {
+		if !fields.Field(i).Bool() {
+			continue
+		}
+		if flag, ok := lookup(fields.Type().Field(i).Name); ok {
+			raw |= flag
+		}
+	}
+	return raw
 }
@@ -0,0 +1,88 @@
+package flags
+
+import (
+	"strings"
+
+	"github.com/BenJuan26/elite/internal/bitset"
+)
+
+// Flags is the bitmask given by the Flags value in Status.json. The flag
+// constants are untyped, so they can be used with both Flags and the
+// uint32 RawFlags of a Status.
+type Flags uint32
+
+// flagNames holds the name of each flag, indexed by its bit position.
+var flagNames = []string{
+	"Docked",
+	"Landed",
+	"LandingGearDown",
+	"ShieldsUp",
+	"Supercruise",
+	"FlightAssistOff",
+	"HardpointsDeployed",
+	"InWing",
+	"LightsOn",
+	"CargoScoopDeployed",
+	"SilentRunning",
+	"ScoopingFuel",
+	"SRVHandbrake",
+	"SRVTurret",
+	"SRVUnderShip",
+	"SRVDriveAssist",
+	"FSDMassLocked",
+	"FSDCharging",
+	"FSDCooldown",
+	"LowFuel",
+	"Overheating",
+	"HasLatLong",
+	"IsInDanger",
+	"BeingInterdicted",
+	"InMainShip",
+	"InFighter",
+	"InSRV",
+	"InAnalysisMode",
+	"NightVision",
+	"AltitudeFromAverageRadius",
+	"FSDJump",
+	"SRVHighBeam",
+}
+
+// Has reports whether all of the given flags are set.
+func (f Flags) Has(flag Flags) bool {
+	return f&flag == flag
+}
+
+// Set returns f with the given flags set.
+func (f Flags) Set(flag Flags) Flags {
+	return f | flag
+}
+
+// Clear returns f with the given flags cleared.
+func (f Flags) Clear(flag Flags) Flags {
+	return f &^ flag
+}
+
+// List returns the names of the flags that are set, such as "Docked",
+// from the lowest bit to the highest.
+func (f Flags) List() []string {
+	return bitset.List(uint32(f), flagNames)
+}
+
+// String returns the names of the flags that are set, separated by "|",
+// such as "Docked|ShieldsUp|InMainShip". It returns an empty string if no flags are set.
+func (f Flags) String() string {
+	return strings.Join(f.List(), "|")
+}
+
+// Lookup returns the flag with the given name, such as "Docked".
+func Lookup(name string) (Flags, bool) {
+	flag, ok := bitset.Lookup(name, flagNames)
+	return Flags(flag), ok
+}
+
+// ParseFlags parses flag names separated by "|", as returned by Flags.String.
+// Whitespace around each name is ignored.
+func ParseFlags(text string) (Flags, error) {
+	f, err := bitset.Parse(text, flagNames)
+	return Flags(f), err
+}
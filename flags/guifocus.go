@@ -0,0 +1,40 @@
+package flags
+
+import "strconv"
+
+// GuiFocus identifies the panel or screen that currently has focus,
+// as given by the GuiFocus value in Status.json.
+type GuiFocus uint32
+
+var guiFocusNames = map[GuiFocus]string{
+	GuiFocusNone:            "None",
+	GuiFocusInternalPanel:   "InternalPanel",
+	GuiFocusExternalPanel:   "ExternalPanel",
+	GuiFocusCommsPanel:      "CommsPanel",
+	GuiFocusRolePanel:       "RolePanel",
+	GuiFocusStationServices: "StationServices",
+	GuiFocusGalaxyMap:       "GalaxyMap",
+	GuiFocusSystemMap:       "SystemMap",
+	GuiFocusOrrery:          "Orrery",
+	GuiFocusFSSMode:         "FSSMode",
+	GuiFocusSAAMode:         "SAAMode",
+	GuiFocusCodex:           "Codex",
+}
+
+// String returns the name of the focus, such as "GalaxyMap".
+func (focus GuiFocus) String() string {
+	if name, ok := guiFocusNames[focus]; ok {
+		return name
+	}
+	return "GuiFocus(" + strconv.FormatUint(uint64(focus), 10) + ")"
+}
+
+// IsMapOpen reports whether the galaxy map, system map or orrery is open.
+func (focus GuiFocus) IsMapOpen() bool {
+	return focus == GuiFocusGalaxyMap || focus == GuiFocusSystemMap || focus == GuiFocusOrrery
+}
+
+// IsPanelFocused reports whether one of the four cockpit panels is focused.
+func (focus GuiFocus) IsPanelFocused() bool {
+	return focus >= GuiFocusInternalPanel && focus <= GuiFocusRolePanel
+}
@@ -0,0 +1,44 @@
+package flags2
+
+const (
+	// OnFoot indicates that the player is on foot.
+	OnFoot = 0x00000001
+	// InTaxi indicates that the player is in a taxi or dropship.
+	InTaxi = 0x00000002
+	// InMulticrew indicates that the player is in someone else's ship.
+	InMulticrew = 0x00000004
+	// OnFootInStation indicates that the player is on foot in a station.
+	OnFootInStation = 0x00000008
+	// OnFootOnPlanet indicates that the player is on foot on a planet surface.
+	OnFootOnPlanet = 0x00000010
+	// AimDownSight indicates that the player is aiming down the sights of their weapon.
+	AimDownSight = 0x00000020
+	// LowOxygen indicates that the player's suit is low on oxygen.
+	LowOxygen = 0x00000040
+	// LowHealth indicates that the player is low on health.
+	LowHealth = 0x00000080
+	// Cold indicates that the player's surroundings are cold.
+	Cold = 0x00000100
+	// Hot indicates that the player's surroundings are hot.
+	Hot = 0x00000200
+	// VeryCold indicates that the player's surroundings are very cold.
+	VeryCold = 0x00000400
+	// VeryHot indicates that the player's surroundings are very hot.
+	VeryHot = 0x00000800
+	// GlideMode indicates that the player is gliding down to a planet surface after disembarking from a dropship.
+	GlideMode = 0x00001000
+	// OnFootInHangar indicates that the player is on foot in a station hangar.
+	OnFootInHangar = 0x00002000
+	// OnFootSocialSpace indicates that the player is on foot in a station's social space.
+	OnFootSocialSpace = 0x00004000
+	// OnFootExterior indicates that the player is on foot outside of a station or settlement building.
+	OnFootExterior = 0x00008000
+	// BreathableAtmosphere indicates that the player's surroundings have a breathable atmosphere.
+	BreathableAtmosphere = 0x00010000
+	// TelepresenceMulticrew indicates that the player is in multicrew through telepresence.
+	TelepresenceMulticrew = 0x00020000
+	// PhysicalMulticrew indicates that the player is physically aboard someone else's ship.
+	PhysicalMulticrew = 0x00040000
+	// FSDHyperdriveCharging indicates that the FSD is charging for a hyperspace jump.
+	FSDHyperdriveCharging = 0x00080000
+)
@@ -0,0 +1,365 @@
+package elite
+
+import (
+	"sort"
+	"time"
+
+	"github.com/BenJuan26/elite/loadout"
+)
+
+// FleetShip is the last known state of one of the commander's ships.
+type FleetShip struct {
+	ShipID        int64
+	Ship          string
+	ShipLocalised string
+	ShipName      string
+	ShipIdent     string
+
+	// Loadout is the most recent Loadout written while flying the ship,
+	// updated by any modules stored or retrieved since. It is nil for
+	// ships that haven't been flown since the journal history began.
+	Loadout *Loadout
+
+	// StarSystem, StationName and MarketID give where the ship is stored.
+	// For the current ship they are the commander's location, and
+	// StationName and MarketID are only set while docked.
+	StarSystem  string
+	StationName string
+	MarketID    int64
+	// InTransit is set while the ship is being transferred to another station.
+	InTransit bool
+	Hot       bool
+
+	// Value is the value of the hull and modules.
+	Value int64
+	// Rebuy is the insurance cost of the ship. It is only known for ships
+	// that have a Loadout.
+	Rebuy int64
+
+	// Updated is the time of the last event that changed the ship.
+	Updated time.Time
+}
+
+// Fleet tracks every ship owned by a commander, built up by applying
+// journal events in order.
+//
+// A Fleet is not safe for concurrent use.
+type Fleet struct {
+	Commander     string
+	CurrentShipID int64
+	Ships         map[int64]*FleetShip
+
+	starSystem  string
+	stationName string
+	marketID    int64
+	// stored holds the modules taken out of ships during the journal
+	// history, so that they keep their engineering when retrieved.
+	stored []loadout.Module
+}
+
+// NewFleet creates an empty Fleet, ready to have events applied to it.
+func NewFleet() *Fleet {
+	return &Fleet{Ships: map[int64]*FleetShip{}}
+}
+
+// CurrentShip returns the ship being flown, or nil if it isn't known yet.
+func (fleet *Fleet) CurrentShip() *FleetShip {
+	return fleet.Ships[fleet.CurrentShipID]
+}
+
+// List returns the ships in the fleet ordered by ShipID.
+func (fleet *Fleet) List() []*FleetShip {
+	ships := make([]*FleetShip, 0, len(fleet.Ships))
+	for _, ship := range fleet.Ships {
+		ships = append(ships, ship)
+	}
+	sort.Slice(ships, func(i, j int) bool {
+		return ships[i].ShipID < ships[j].ShipID
+	})
+	return ships
+}
+
+// Apply updates the fleet with a single journal event.
+// Events that don't affect the fleet are ignored.
+func (fleet *Fleet) Apply(event Event) {
+	switch e := event.(type) {
+	case *Commander:
+		fleet.setCommander(e.Name)
+	case *LoadGame:
+		fleet.setCommander(e.Commander)
+		ship := fleet.ship(e.ShipID, e)
+		ship.Ship = e.Ship
+		ship.ShipLocalised = e.ShipLocalised
+		ship.ShipName = e.ShipName
+		ship.ShipIdent = e.ShipIdent
+		fleet.CurrentShipID = e.ShipID
+	case *Location:
+		if e.Docked {
+			fleet.setLocation(e.StarSystem, e.StationName, e.MarketID)
+		} else {
+			fleet.setLocation(e.StarSystem, "", 0)
+		}
+	case *FSDJump:
+		fleet.setLocation(e.StarSystem, "", 0)
+	case *Docked:
+		fleet.setLocation(e.StarSystem, e.StationName, e.MarketID)
+	case *Undocked:
+		fleet.setLocation(fleet.starSystem, "", 0)
+	case *Loadout:
+		ship := fleet.ship(e.ShipID, e)
+		ship.Ship = e.Ship
+		ship.ShipName = e.ShipName
+		ship.ShipIdent = e.ShipIdent
+		ship.Value = e.HullValue + e.ModulesValue
+		ship.Rebuy = e.Rebuy
+		ship.Hot = e.Hot
+		// Copy the modules, since stored and retrieved modules are applied to them.
+		l := *e
+		l.Modules = append([]loadout.Module(nil), e.Modules...)
+		ship.Loadout = &l
+		fleet.CurrentShipID = e.ShipID
+		fleet.setLocation(fleet.starSystem, fleet.stationName, fleet.marketID)
+	case *SetUserShipName:
+		ship := fleet.ship(e.ShipID, e)
+		ship.ShipName = e.UserShipName
+		ship.ShipIdent = e.UserShipID
+	case *ShipyardBuy:
+		if e.StoreShipID != 0 {
+			fleet.store(e.StoreShipID, e)
+		}
+		if e.SellShipID != 0 {
+			delete(fleet.Ships, e.SellShipID)
+		}
+	case *ShipyardNew:
+		ship := fleet.ship(e.NewShipID, e)
+		ship.Ship = e.ShipType
+		ship.ShipLocalised = e.ShipTypeLocalised
+		fleet.CurrentShipID = e.NewShipID
+		fleet.setLocation(fleet.starSystem, fleet.stationName, fleet.marketID)
+	case *ShipyardSwap:
+		if e.StoreShipID != 0 {
+			fleet.store(e.StoreShipID, e)
+		}
+		if e.SellShipID != 0 {
+			delete(fleet.Ships, e.SellShipID)
+		}
+		ship := fleet.ship(e.ShipID, e)
+		ship.Ship = e.ShipType
+		ship.ShipLocalised = e.ShipTypeLocalised
+		fleet.CurrentShipID = e.ShipID
+		fleet.setLocation(fleet.starSystem, fleet.stationName, fleet.marketID)
+	case *ShipyardSell:
+		delete(fleet.Ships, e.SellShipID)
+	case *ShipyardTransfer:
+		ship := fleet.ship(e.ShipID, e)
+		ship.StarSystem = fleet.starSystem
+		ship.StationName = fleet.stationName
+		ship.MarketID = e.MarketID
+		ship.InTransit = e.TransferTime > 0
+	case *StoredShips:
+		fleet.applyStoredShips(e)
+	case *ModuleBuy:
+		previous, ok := fleet.setModule(e.ShipID, e.Slot, loadout.Module{Item: e.BuyItem, Health: 1}, e)
+		if ok && e.StoredItem != "" {
+			fleet.stored = append(fleet.stored, previous)
+		}
+	case *ModuleSell:
+		fleet.setModule(e.ShipID, e.Slot, loadout.Module{}, e)
+	case *ModuleStore:
+		previous, ok := fleet.setModule(e.ShipID, e.Slot, loadout.Module{Item: e.ReplacementItem, Health: 1}, e)
+		if ok {
+			fleet.stored = append(fleet.stored, previous)
+		}
+	case *ModuleRetrieve:
+		module := fleet.retrieve(e.RetrievedItem, e.EngineerModifications, e.Level, e.Quality)
+		previous, ok := fleet.setModule(e.ShipID, e.Slot, module, e)
+		if ok && e.SwapOutItem != "" {
+			fleet.stored = append(fleet.stored, previous)
+		}
+	case *MassModuleStore:
+		for _, item := range e.Items {
+			if previous, ok := fleet.setModule(e.ShipID, item.Slot, loadout.Module{}, e); ok {
+				fleet.stored = append(fleet.stored, previous)
+			}
+		}
+	}
+}
+
+// setCommander starts over with an empty fleet if a different commander is loaded.
+func (fleet *Fleet) setCommander(name string) {
+	if fleet.Commander != "" && fleet.Commander != name {
+		*fleet = *NewFleet()
+	}
+	fleet.Commander = name
+}
+
+// ship returns the ship with the given ID, adding it to the fleet if needed,
+// and marks it as updated by event.
+func (fleet *Fleet) ship(id int64, event Event) *FleetShip {
+	ship, ok := fleet.Ships[id]
+	if !ok {
+		ship = &FleetShip{ShipID: id}
+		fleet.Ships[id] = ship
+	}
+	ship.Updated = event.EventTime()
+	return ship
+}
+
+// setLocation records the commander's location, which is also the location of the current ship.
+func (fleet *Fleet) setLocation(system, station string, marketID int64) {
+	fleet.starSystem = system
+	fleet.stationName = station
+	fleet.marketID = marketID
+
+	if ship := fleet.CurrentShip(); ship != nil {
+		ship.StarSystem = system
+		ship.StationName = station
+		ship.MarketID = marketID
+		ship.InTransit = false
+	}
+}
+
+// store records that a ship was left at the current station.
+func (fleet *Fleet) store(id int64, event Event) {
+	ship := fleet.ship(id, event)
+	ship.StarSystem = fleet.starSystem
+	ship.StationName = fleet.stationName
+	ship.MarketID = fleet.marketID
+	ship.InTransit = false
+}
+
+// applyStoredShips updates the stored ships from the complete list given by
+// a StoredShips event. Ships that are neither listed nor being flown have
+// been sold or lost, and are removed.
+func (fleet *Fleet) applyStoredShips(e *StoredShips) {
+	listed := map[int64]bool{fleet.CurrentShipID: true}
+	update := func(stored StoredShip, system, station string, marketID int64) {
+		listed[stored.ShipID] = true
+		ship := fleet.ship(stored.ShipID, e)
+		ship.Ship = stored.ShipType
+		ship.ShipLocalised = stored.ShipTypeLocalised
+		ship.ShipName = stored.Name
+		ship.Value = stored.Value
+		ship.Hot = stored.Hot
+		ship.StarSystem = system
+		ship.StationName = station
+		ship.MarketID = marketID
+		ship.InTransit = stored.InTransit
+	}
+
+	for _, stored := range e.ShipsHere {
+		update(stored, e.StarSystem, e.StationName, e.MarketID)
+	}
+	for _, stored := range e.ShipsRemote {
+		update(stored, stored.StarSystem, "", stored.ShipMarketID)
+	}
+
+	for id := range fleet.Ships {
+		if !listed[id] {
+			delete(fleet.Ships, id)
+		}
+	}
+}
+
+// setModule fits module to a slot in the known loadout of a ship, or
+// empties the slot if module has no Item. The new module keeps the
+// priority of the one it replaces. It returns the module that was in the
+// slot, and false if the slot was empty or the ship's loadout isn't known.
+func (fleet *Fleet) setModule(id int64, slot string, module loadout.Module, event Event) (loadout.Module, bool) {
+	ship, ok := fleet.Ships[id]
+	if !ok || ship.Loadout == nil {
+		return loadout.Module{}, false
+	}
+	ship.Updated = event.EventTime()
+	// Events name modules like "$int_cargorack_size4_class1_name;", while
+	// the Loadout names them like "int_cargorack_size4_class1".
+	module.Item = moduleSymbol(module.Item)
+	module.Slot = slot
+	module.On = true
+
+	modules := ship.Loadout.Modules
+	for i := range modules {
+		if modules[i].Slot != slot {
+			continue
+		}
+		previous := modules[i]
+		if module.Item == "" {
+			ship.Loadout.Modules = append(modules[:i], modules[i+1:]...)
+		} else {
+			module.Priority = previous.Priority
+			modules[i] = module
+		}
+		return previous, true
+	}
+	if module.Item != "" {
+		ship.Loadout.Modules = append(modules, module)
+	}
+	return loadout.Module{}, false
+}
+
+// retrieve takes the named module out of the stored modules, preferring one
+// with the given blueprint. If the module was stored before the journal
+// history began, only its blueprint, level and quality are known.
+func (fleet *Fleet) retrieve(item, blueprint string, level int64, quality float64) loadout.Module {
+	for i, module := range fleet.stored {
+		if moduleSymbol(module.Item) == moduleSymbol(item) && module.Engineering.BlueprintName == blueprint {
+			fleet.stored = append(fleet.stored[:i], fleet.stored[i+1:]...)
+			return module
+		}
+	}
+
+	module := loadout.Module{Item: item, Health: 1}
+	if e := engineering(blueprint, level, quality); e != nil {
+		module.Engineering = *e
+	}
+	return module
+}
+
+// fleetEvents are the events that affect a Fleet.
+var fleetEvents = []string{
+	"Commander", "LoadGame", "Location", "FSDJump", "Docked", "Undocked", "Loadout",
+	"SetUserShipName", "ShipyardBuy", "ShipyardNew", "ShipyardSwap", "ShipyardSell",
+	"ShipyardTransfer", "StoredShips", "ModuleBuy", "ModuleSell", "ModuleStore",
+	"ModuleRetrieve", "MassModuleStore",
+}
+
+// GetFleet builds the fleet of the most recent commander from the journal files.
+// It will read them from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetFleetFromPath.
+func GetFleet() (*Fleet, error) {
+	return GetFleetFromPath(defaultLogPath())
+}
+
+// GetFleetFromPath builds the fleet of the most recent commander from the journal files at the specified path.
+// Unlike GetCommanderState, every journal file is read, since a ship's last
+// Loadout may have been written long ago.
+func GetFleetFromPath(logPath string) (*Fleet, error) {
+	return NewClient(WithLogPath(logPath)).GetFleet()
+}
+
+// GetFleet builds the fleet of the most recent commander from the journal files.
+func (c *Client) GetFleet() (*Fleet, error) {
+	fleet := NewFleet()
+
+	commander, err := c.lastCommander()
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := c.OpenJournal(JournalFilter{Events: fleetEvents, Commander: commander})
+	if err != nil {
+		return nil, err
+	}
+	defer journal.Close()
+	for journal.Next() {
+		fleet.Apply(journal.Event())
+	}
+	if err := journal.Err(); err != nil {
+		return nil, err
+	}
+	return fleet, nil
+}
@@ -0,0 +1,82 @@
+package elite_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestGetFleetFromPath(t *testing.T) {
+	fleet, err := elite.GetFleetFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get fleet: " + err.Error())
+		t.FailNow()
+	}
+
+	ship := fleet.CurrentShip()
+	if ship == nil || ship.ShipID != 15 || ship.Loadout == nil || ship.Loadout.Ship != "krait_light" {
+		fmt.Println("Incorrect current ship in fleet")
+		t.FailNow()
+	}
+}
+
+func TestFleetApply(t *testing.T) {
+	fleet := elite.NewFleet()
+	applyLines(t, fleet,
+		`{ "timestamp":"2021-05-20T19:40:00Z", "event":"LoadGame", "Commander":"Jameson", "Ship":"krait_light", "ShipID":15, "ShipName":"dora winifred", "ShipIdent":"cp1-dw" }`,
+		`{ "timestamp":"2021-05-20T19:40:02Z", "event":"Location", "Docked":true, "StationName":"Jameson Memorial", "MarketID":128666762, "StarSystem":"Shinrarta Dezhra" }`,
+		`{ "timestamp":"2021-05-20T19:40:03Z", "event":"Loadout", "Ship":"krait_light", "ShipID":15, "ShipName":"dora winifred", "ShipIdent":"cp1-dw", "HullValue":44000000, "ModulesValue":60000000, "Rebuy":5200000, "Modules":[ { "Slot":"Slot01_Size5", "Item":"int_shieldgenerator_size5_class3_fast", "On":true, "Priority":0 }, { "Slot":"Slot02_Size4", "Item":"int_cargorack_size4_class1", "On":true, "Priority":1 } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"ModuleStore", "MarketID":128666762, "Slot":"Slot02_Size4", "StoredItem":"int_cargorack_size4_class1", "Ship":"krait_light", "ShipID":15 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"ShipyardSwap", "MarketID":128666762, "ShipType":"anaconda", "ShipType_Localised":"Anaconda", "ShipID":3, "StoreOldShip":"krait_light", "StoreShipID":15 }`,
+		`{ "timestamp":"2021-05-20T19:43:00Z", "event":"StoredShips", "StationName":"Jameson Memorial", "MarketID":128666762, "StarSystem":"Shinrarta Dezhra", "ShipsHere":[ { "ShipID":15, "ShipType":"krait_light", "Name":"dora winifred", "Value":104000000, "Hot":false } ], "ShipsRemote":[ { "ShipID":7, "ShipType":"sidewinder", "StarSystem":"Sol", "ShipMarketID":128016640, "TransferPrice":1000, "TransferTime":600, "Value":30000, "Hot":false } ] }`,
+		`{ "timestamp":"2021-05-20T19:44:00Z", "event":"ShipyardSell", "MarketID":128666762, "ShipType":"sidewinder", "SellShipID":7, "ShipPrice":27000, "System":"Sol" }`,
+	)
+
+	if fleet.Commander != "Jameson" || fleet.CurrentShipID != 3 || len(fleet.Ships) != 2 {
+		fmt.Printf("Incorrect fleet: %d ships, current %d\n", len(fleet.Ships), fleet.CurrentShipID)
+		t.FailNow()
+	}
+
+	krait := fleet.Ships[15]
+	if krait.StationName != "Jameson Memorial" || krait.Value != 104000000 || krait.Rebuy != 5200000 {
+		fmt.Printf("Incorrect stored ship: %+v\n", krait)
+		t.FailNow()
+	}
+	if len(krait.Loadout.Modules) != 1 || krait.Loadout.Modules[0].Slot != "Slot01_Size5" {
+		fmt.Println("Stored module should be removed from the ship's loadout")
+		t.FailNow()
+	}
+
+	anaconda := fleet.CurrentShip()
+	if anaconda.Ship != "anaconda" || anaconda.StarSystem != "Shinrarta Dezhra" || anaconda.Loadout != nil {
+		fmt.Printf("Incorrect current ship: %+v\n", anaconda)
+		t.FailNow()
+	}
+	if ships := fleet.List(); ships[0].ShipID != 3 || ships[1].ShipID != 15 {
+		fmt.Println("Ships should be listed by ShipID")
+		t.FailNow()
+	}
+}
+
+func TestFleetRetrieveEngineeredModule(t *testing.T) {
+	fleet := elite.NewFleet()
+	applyLines(t, fleet,
+		`{ "timestamp":"2021-05-20T19:40:00Z", "event":"LoadGame", "Commander":"Jameson", "Ship":"krait_light", "ShipID":15 }`,
+		`{ "timestamp":"2021-05-20T19:40:03Z", "event":"Loadout", "Ship":"krait_light", "ShipID":15, "Modules":[ { "Slot":"Slot01_Size5", "Item":"int_shieldgenerator_size5_class3_fast", "On":true, "Priority":0, "Health":0.9, "Engineering":{ "Engineer":"Lei Cheung", "BlueprintName":"ShieldGenerator_Reinforced", "Level":5, "Quality":1.0, "Modifiers":[ { "Label":"Integrity", "Value":200.0, "OriginalValue":100.0, "LessIsGood":0 } ] } }, { "Slot":"Slot02_Size5", "Item":"int_cargorack_size5_class1", "On":true, "Priority":3, "Health":1.0 } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"ModuleStore", "MarketID":128666762, "Slot":"Slot01_Size5", "StoredItem":"int_shieldgenerator_size5_class3_fast", "Ship":"krait_light", "ShipID":15, "EngineerModifications":"ShieldGenerator_Reinforced", "Level":5, "Quality":1.0 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"ModuleRetrieve", "MarketID":128666762, "Slot":"Slot02_Size5", "RetrievedItem":"$int_shieldgenerator_size5_class3_fast_name;", "Ship":"krait_light", "ShipID":15, "EngineerModifications":"ShieldGenerator_Reinforced", "Level":5, "Quality":1.0, "SwapOutItem":"$int_cargorack_size5_class1_name;" }`,
+	)
+
+	modules := fleet.Ships[15].Loadout.Modules
+	if len(modules) != 1 {
+		fmt.Printf("Expected 1 module, got %d\n", len(modules))
+		t.FailNow()
+	}
+	shield := modules[0]
+	if shield.Slot != "Slot02_Size5" || shield.Item != "int_shieldgenerator_size5_class3_fast" || shield.Priority != 3 || shield.Health != 0.9 ||
+		shield.Engineering.Engineer != "Lei Cheung" || len(shield.Engineering.Modifiers) != 1 {
+		fmt.Printf("Retrieved module lost its engineering or priority: %+v\n", shield)
+		t.FailNow()
+	}
+}
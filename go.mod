@@ -1,3 +1,3 @@
 module github.com/BenJuan26/elite
 
-go 1.12
+go 1.16
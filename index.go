@@ -0,0 +1,299 @@
+package elite
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// indexVersion is incremented whenever the format of the index file changes,
+// so that indexes written by older versions are rebuilt rather than misread.
+const indexVersion = 1
+
+// IndexEntry locates a single event in the journal files.
+type IndexEntry struct {
+	Event     string    `json:"e"`
+	File      string    `json:"-"`
+	Offset    int64     `json:"o"`
+	Timestamp time.Time `json:"t"`
+}
+
+// indexedFile is the indexed portion of a single journal file.
+type indexedFile struct {
+	// Head is a hash of the first line, used to notice files that were replaced.
+	Head uint64 `json:"head"`
+	// Indexed is the offset just past the last complete line that was indexed.
+	Indexed int64        `json:"indexed"`
+	ModTime time.Time    `json:"modTime"`
+	Entries []IndexEntry `json:"entries"`
+}
+
+// Index is a persistent index of the events in the journal files, which
+// lets events be found by name and read with a single seek rather than by
+// scanning every file.
+//
+// The index is updated incrementally: only lines written since the last
+// update are read, and files that were truncated, replaced or deleted are
+// reindexed or dropped. An Index is not safe for concurrent use.
+type Index struct {
+	client *Client
+	path   string
+	files  map[string]*indexedFile
+	order  []string
+}
+
+// indexData is the content of the index file.
+type indexData struct {
+	Version int                     `json:"version"`
+	Files   map[string]*indexedFile `json:"files"`
+}
+
+// DefaultIndexPath returns the path of the index file for the specified log
+// path in the user's cache directory.
+func DefaultIndexPath(logPath string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	absPath, err := filepath.Abs(logPath)
+	if err != nil {
+		return "", err
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(absPath))
+	return filepath.Join(cacheDir, "elite", fmt.Sprintf("index-%016x.json", hash.Sum64())), nil
+}
+
+// OpenIndex loads the index for the journal files at logPath from indexPath,
+// and brings it up to date with the journal files. If the index file
+// doesn't exist or can't be read, a new index is built.
+// Call Save to write the updated index back to indexPath.
+func OpenIndex(logPath, indexPath string) (*Index, error) {
+	return NewClient(WithLogPath(logPath)).OpenIndex(indexPath)
+}
+
+// OpenIndex loads the index for the Client's journal files from indexPath,
+// and brings it up to date with the journal files.
+func (c *Client) OpenIndex(indexPath string) (*Index, error) {
+	index := &Index{client: c, path: indexPath}
+
+	if content, err := ioutil.ReadFile(indexPath); err == nil {
+		data := indexData{}
+		if err := json.Unmarshal(content, &data); err == nil && data.Version == indexVersion {
+			index.files = data.Files
+			for name, indexed := range index.files {
+				for i := range indexed.Entries {
+					indexed.Entries[i].File = name
+				}
+			}
+		}
+	}
+	if index.files == nil {
+		index.files = map[string]*indexedFile{}
+	}
+
+	if err := index.Update(); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// Update indexes any events written since the last update.
+func (index *Index) Update() error {
+	files, err := index.client.ListJournalFiles()
+	if err != nil {
+		return err
+	}
+
+	present := map[string]bool{}
+	index.order = index.order[:0]
+	for _, file := range files {
+		present[file.Name] = true
+		index.order = append(index.order, file.Name)
+		if err := index.updateFile(file.Name); err != nil {
+			return err
+		}
+	}
+
+	for name := range index.files {
+		if !present[name] {
+			delete(index.files, name)
+		}
+	}
+	return nil
+}
+
+// updateFile indexes the lines added to a single journal file since it was last indexed.
+func (index *Index) updateFile(name string) error {
+	info, err := fs.Stat(index.client.fsys, name)
+	if err != nil {
+		return err
+	}
+
+	indexed := index.files[name]
+	if indexed != nil && info.Size() == indexed.Indexed && info.ModTime().Equal(indexed.ModTime) {
+		return nil
+	}
+
+	head, err := index.headHash(name)
+	if err != nil {
+		return err
+	}
+	if indexed == nil || indexed.Head != head || head == 0 || info.Size() < indexed.Indexed {
+		indexed = &indexedFile{Head: head}
+		index.files[name] = indexed
+	}
+
+	journalFile, err := index.client.openAt(name, indexed.Indexed)
+	if err != nil {
+		return err
+	}
+	defer journalFile.Close()
+	reader := bufio.NewReader(journalFile)
+
+	offset := indexed.Indexed
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// An incomplete last line is left for the next update.
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		entry := JournalEntry{}
+		if json.Unmarshal(line, &entry) == nil && entry.Event != "" {
+			indexed.Entries = append(indexed.Entries, IndexEntry{
+				Event:     entry.Event,
+				File:      name,
+				Offset:    offset,
+				Timestamp: entry.EventTime(),
+			})
+		}
+		offset += int64(len(line))
+	}
+
+	indexed.Indexed = offset
+	indexed.ModTime = info.ModTime()
+	return nil
+}
+
+// headHash returns a hash of the first line of the named journal file,
+// or zero if the first line hasn't been completely written yet.
+func (index *Index) headHash(name string) (uint64, error) {
+	journalFile, err := index.client.fsys.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer journalFile.Close()
+
+	firstLine, err := bufio.NewReader(journalFile).ReadBytes('\n')
+	if err == io.EOF {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	hash := fnv.New64a()
+	hash.Write(firstLine)
+	return hash.Sum64(), nil
+}
+
+// Save writes the index to the path it was opened from, creating the directory if needed.
+func (index *Index) Save() error {
+	content, err := json.Marshal(indexData{Version: indexVersion, Files: index.files})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(index.path), 0755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a crash can't leave a corrupt index behind.
+	tempPath := index.path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, index.path)
+}
+
+// Lookup returns the location of every indexed event with one of the given
+// names, oldest first. If no names are given, every indexed event is returned.
+func (index *Index) Lookup(names ...string) []IndexEntry {
+	var wanted map[string]bool
+	if len(names) > 0 {
+		wanted = map[string]bool{}
+		for _, name := range names {
+			wanted[name] = true
+		}
+	}
+
+	var entries []IndexEntry
+	for _, name := range index.order {
+		indexed := index.files[name]
+		if indexed == nil {
+			continue
+		}
+		for _, entry := range indexed.Entries {
+			if wanted == nil || wanted[entry.Event] {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// Last returns the location of the most recent event with one of the given names.
+func (index *Index) Last(names ...string) (IndexEntry, bool) {
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	for i := len(index.order) - 1; i >= 0; i-- {
+		indexed := index.files[index.order[i]]
+		if indexed == nil {
+			continue
+		}
+		for j := len(indexed.Entries) - 1; j >= 0; j-- {
+			if wanted[indexed.Entries[j].Event] {
+				return indexed.Entries[j], true
+			}
+		}
+	}
+	return IndexEntry{}, false
+}
+
+// ReadEvent reads the event at the location given by an index entry.
+// If the journal file no longer matches the index, an error is returned and
+// the file is reindexed on the next call to Update.
+func (index *Index) ReadEvent(entry IndexEntry) (Event, error) {
+	journalFile, err := index.client.openAt(entry.File, entry.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer journalFile.Close()
+
+	line, err := bufio.NewReader(journalFile).ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	event, err := ParseEvent(line)
+	if err != nil || event.EventName() != entry.Event {
+		delete(index.files, entry.File)
+		return nil, errors.New("Index is out of date for " + entry.File)
+	}
+	return event, nil
+}
@@ -0,0 +1,86 @@
+package elite_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestIndex(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+	indexPath := filepath.Join(dir, "index", "journal-index.json")
+
+	index, err := elite.OpenIndex(dir, indexPath)
+	if err != nil {
+		fmt.Println("Couldn't open index: " + err.Error())
+		t.FailNow()
+	}
+
+	jumps := index.Lookup("FSDJump")
+	if len(jumps) != 3 || jumps[0].File != "Journal.200110120000.01.log" {
+		fmt.Printf("Incorrect jumps in index: Expecting 3, got %d\n", len(jumps))
+		t.FailNow()
+	}
+
+	entry, ok := index.Last("FSDJump")
+	if !ok {
+		fmt.Println("Last FSDJump not found in index")
+		t.FailNow()
+	}
+	event, err := index.ReadEvent(entry)
+	if err != nil {
+		fmt.Println("Couldn't read indexed event: " + err.Error())
+		t.FailNow()
+	}
+	if event.(*elite.FSDJump).StarSystem != "Lave" {
+		fmt.Println("Incorrect last jump")
+		t.FailNow()
+	}
+
+	if err := index.Save(); err != nil {
+		fmt.Println("Couldn't save index: " + err.Error())
+		t.FailNow()
+	}
+
+	// Append to one journal and replace another, then reopen the saved index.
+	appendLine(t, filepath.Join(dir, "Journal.2021-06-01T080000.01.log"), `{ "timestamp":"2021-06-01T08:20:00Z", "event":"FSDJump", "StarSystem":"Leesti" }`+"\n")
+	replaced := `{ "timestamp":"2020-01-10T12:20:00Z", "event":"Fileheader", "part":2, "build":"replaced" }` + "\n" +
+		`{ "timestamp":"2020-01-10T12:40:00Z", "event":"Docked", "StationName":"Jameson Memorial" }` + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "Journal.200110122000.02.log"), []byte(replaced), 0644); err != nil {
+		fmt.Println("Couldn't replace journal file: " + err.Error())
+		t.FailNow()
+	}
+
+	index, err = elite.OpenIndex(dir, indexPath)
+	if err != nil {
+		fmt.Println("Couldn't reopen index: " + err.Error())
+		t.FailNow()
+	}
+
+	jumps = index.Lookup("FSDJump")
+	if len(jumps) != 3 {
+		fmt.Printf("Incorrect jumps in updated index: Expecting 3, got %d\n", len(jumps))
+		t.FailNow()
+	}
+	event, err = index.ReadEvent(jumps[2])
+	if err != nil || event.(*elite.FSDJump).StarSystem != "Leesti" {
+		fmt.Println("Appended jump was not indexed")
+		t.FailNow()
+	}
+
+	docked := index.Lookup("Docked")
+	if len(docked) != 1 {
+		fmt.Println("Replaced journal file was not reindexed")
+		t.FailNow()
+	}
+	event, err = index.ReadEvent(docked[0])
+	if err != nil || event.(*elite.Docked).StationName != "Jameson Memorial" {
+		fmt.Println("Couldn't read event from replaced journal file")
+		t.FailNow()
+	}
+}
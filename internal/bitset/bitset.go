@@ -0,0 +1,48 @@
+// Package bitset names the bits of the flag bitmasks in Status.json, so that
+// the flags and flags2 packages can share one implementation.
+package bitset
+
+import (
+	"errors"
+	"math/bits"
+	"strings"
+)
+
+// List returns the names of the bits that are set in value, from the lowest
+// bit to the highest. names holds the name of each bit, indexed by position.
+func List(value uint32, names []string) []string {
+	list := make([]string, 0, bits.OnesCount32(value))
+	for i, name := range names {
+		if value&(1<<uint(i)) != 0 {
+			list = append(list, name)
+		}
+	}
+	return list
+}
+
+// Lookup returns the bit with the given name.
+func Lookup(name string, names []string) (uint32, bool) {
+	for i, bitName := range names {
+		if bitName == name {
+			return 1 << uint(i), true
+		}
+	}
+	return 0, false
+}
+
+// Parse parses bit names separated by "|". Whitespace around each name is ignored.
+func Parse(text string, names []string) (uint32, error) {
+	var value uint32
+	if strings.TrimSpace(text) == "" {
+		return value, nil
+	}
+
+	for _, name := range strings.Split(text, "|") {
+		bit, ok := Lookup(strings.TrimSpace(name), names)
+		if !ok {
+			return 0, errors.New("Unknown flag: " + strings.TrimSpace(name))
+		}
+		value |= bit
+	}
+	return value, nil
+}
@@ -0,0 +1,225 @@
+package elite
+
+import (
+	"bufio"
+	"io/fs"
+	"time"
+)
+
+// JournalFilter selects the events returned by a Journal.
+// Fields left at their zero value match every event.
+type JournalFilter struct {
+	// Events lists the names of the events to return, such as "FSDJump".
+	Events []string
+	// Since excludes events before this time.
+	Since time.Time
+	// Until excludes events at or after this time.
+	Until time.Time
+	// Commander only returns events written while the named commander was playing.
+	Commander string
+	// Match, if set, is called for every event that passes the other
+	// criteria, and excludes the event if it returns false.
+	Match func(Event) bool
+}
+
+// fileTimeMargin allows for journal file names being written in the local
+// time of the machine that wrote them, which may not be this one.
+const fileTimeMargin = 24 * time.Hour
+
+// Journal iterates over the events in every journal file in a log directory,
+// oldest first. Files are read one line at a time, so the whole history
+// never needs to fit in memory.
+//
+//	journal, err := elite.OpenJournal(logPath, elite.JournalFilter{Events: []string{"FSDJump"}})
+//	if err != nil {
+//	    return err
+//	}
+//	defer journal.Close()
+//	for journal.Next() {
+//	    jump := journal.Event().(*elite.FSDJump)
+//	    ...
+//	}
+//	return journal.Err()
+type Journal struct {
+	// Strict stops iteration at lines that can't be parsed, reporting a
+	// *ParseError through Err, rather than skipping them.
+	Strict bool
+
+	client *Client
+	filter JournalFilter
+	events map[string]bool
+	files  []JournalFile
+	next   int
+
+	journalFile fs.File
+	fileName    string
+	lineNumber  int
+	scanner     *bufio.Scanner
+	commander   string
+	event       Event
+	err         error
+}
+
+// OpenJournal creates a Journal over the journal files at the specified log path.
+func OpenJournal(logPath string, filter JournalFilter) (*Journal, error) {
+	return NewClient(WithLogPath(logPath)).OpenJournal(filter)
+}
+
+// OpenJournal creates a Journal over the Client's journal files.
+func (c *Client) OpenJournal(filter JournalFilter) (*Journal, error) {
+	files, err := c.ListJournalFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	j := &Journal{Strict: c.strict, client: c, filter: filter, files: files}
+	if len(filter.Events) > 0 {
+		j.events = map[string]bool{}
+		for _, name := range filter.Events {
+			j.events[name] = true
+		}
+	}
+
+	// Skip files that ended before the start of the time range, which is
+	// when the next file was started. Continuation parts are never skipped
+	// without their first part, which names the commander.
+	if !filter.Since.IsZero() {
+		since := filter.Since.Add(-fileTimeMargin)
+		for j.next+1 < len(files) && !files[j.next+1].Time.After(since) {
+			j.next++
+		}
+		for j.next > 0 && files[j.next].Part > 1 {
+			j.next--
+		}
+	}
+
+	return j, nil
+}
+
+// Next advances to the next matching event, which is then available through Event.
+// It returns false when there are no more events or an error occurs.
+func (j *Journal) Next() bool {
+	for {
+		if j.scanner == nil && !j.openNextFile() {
+			return false
+		}
+
+		if !j.scanner.Scan() {
+			if err := j.scanner.Err(); err != nil {
+				j.err = err
+				return false
+			}
+			j.closeFile()
+			continue
+		}
+
+		j.lineNumber++
+		line := j.scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		entry, err := parseHeader(line)
+		if err != nil {
+			if j.Strict {
+				j.err = &ParseError{File: j.fileName, Line: j.lineNumber, Text: string(line), Err: err}
+				return false
+			}
+			j.client.logf("Skipping %s line %d: %v", j.fileName, j.lineNumber, err)
+			continue
+		}
+
+		if entry.Event == "Commander" || entry.Event == "LoadGame" {
+			if event, err := ParseEvent(line); err == nil {
+				switch e := event.(type) {
+				case *Commander:
+					j.commander = e.Name
+				case *LoadGame:
+					j.commander = e.Commander
+				}
+			}
+		}
+
+		if j.events != nil && !j.events[entry.Event] {
+			continue
+		}
+		if j.filter.Commander != "" && j.filter.Commander != j.commander {
+			continue
+		}
+		if !j.filter.Since.IsZero() || !j.filter.Until.IsZero() {
+			t := entry.EventTime()
+			if t.Before(j.filter.Since) {
+				continue
+			}
+			if !j.filter.Until.IsZero() && !t.Before(j.filter.Until) {
+				continue
+			}
+		}
+
+		event, err := ParseEvent(line)
+		if err != nil {
+			if j.Strict {
+				j.err = &ParseError{File: j.fileName, Line: j.lineNumber, Text: string(line), Err: err}
+				return false
+			}
+			j.client.logf("Skipping %s line %d: %v", j.fileName, j.lineNumber, err)
+			continue
+		}
+		if j.filter.Match != nil && !j.filter.Match(event) {
+			continue
+		}
+
+		j.event = event
+		return true
+	}
+}
+
+// Event returns the event found by the last call to Next.
+func (j *Journal) Event() Event {
+	return j.event
+}
+
+// Err returns the error that stopped iteration, if any.
+func (j *Journal) Err() error {
+	return j.err
+}
+
+// Close closes the journal file currently being read.
+func (j *Journal) Close() error {
+	j.next = len(j.files)
+	return j.closeFile()
+}
+
+// openNextFile opens the next journal file in the time range, if there is one.
+func (j *Journal) openNextFile() bool {
+	if j.next >= len(j.files) {
+		return false
+	}
+	file := j.files[j.next]
+	if !j.filter.Until.IsZero() && !file.Time.Before(j.filter.Until.Add(fileTimeMargin)) {
+		return false
+	}
+	j.next++
+
+	journalFile, err := j.client.fsys.Open(file.Name)
+	if err != nil {
+		j.err = err
+		return false
+	}
+
+	j.journalFile = journalFile
+	j.fileName = file.Name
+	j.lineNumber = 0
+	j.scanner = bufio.NewScanner(journalFile)
+	j.scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	return true
+}
+
+func (j *Journal) closeFile() error {
+	j.scanner = nil
+	if j.journalFile == nil {
+		return nil
+	}
+	err := j.journalFile.Close()
+	j.journalFile = nil
+	return err
+}
@@ -0,0 +1,176 @@
+package elite_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func writeJournals(t *testing.T, journals map[string][]string) string {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+
+	for name, lines := range journals {
+		content := strings.Join(lines, "\n") + "\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			fmt.Println("Couldn't write journal file: " + err.Error())
+			t.FailNow()
+		}
+	}
+	return dir
+}
+
+var historyJournals = map[string][]string{
+	"Journal.200110120000.01.log": {
+		`{ "timestamp":"2020-01-10T12:00:00Z", "event":"Fileheader", "part":1 }`,
+		`{ "timestamp":"2020-01-10T12:00:01Z", "event":"Commander", "FID":"F1", "Name":"Jameson" }`,
+		`{ "timestamp":"2020-01-10T12:05:00Z", "event":"FSDJump", "StarSystem":"Sol" }`,
+		`{ "timestamp":"2020-01-10T12:10:00Z", "event":"MarketSell", "Type":"gold", "Count":10, "SellPrice":9000, "TotalSale":90000 }`,
+		`not json at all`,
+		`{ "timestamp":"2020-01-10T12:20:00Z", "event":"Continued", "Part":2 }`,
+	},
+	"Journal.200110122000.02.log": {
+		`{ "timestamp":"2020-01-10T12:20:00Z", "event":"Fileheader", "part":2 }`,
+		`{ "timestamp":"2020-01-10T12:25:00Z", "event":"FSDJump", "StarSystem":"Alpha Centauri" }`,
+		`{ "timestamp":"2020-01-10T12:30:00Z", "event":"MarketSell", "Type":"silver", "Count":5, "SellPrice":4000, "TotalSale":20000 }`,
+	},
+	"Journal.2021-06-01T080000.01.log": {
+		`{ "timestamp":"2021-06-01T08:00:00Z", "event":"Fileheader", "part":1 }`,
+		`{ "timestamp":"2021-06-01T08:00:01Z", "event":"Commander", "FID":"F2", "Name":"Alt" }`,
+		`{ "timestamp":"2021-06-01T08:05:00Z", "event":"FSDJump", "StarSystem":"Lave" }`,
+		`{ "timestamp":"2021-06-01T08:10:00Z", "event":"MarketSell", "Type":"gold", "Count":1, "SellPrice":9500, "TotalSale":9500 }`,
+	},
+}
+
+func collectEvents(t *testing.T, dir string, filter elite.JournalFilter) []elite.Event {
+	journal, err := elite.OpenJournal(dir, filter)
+	if err != nil {
+		fmt.Println("Couldn't open journal: " + err.Error())
+		t.FailNow()
+	}
+	defer journal.Close()
+
+	var events []elite.Event
+	for journal.Next() {
+		events = append(events, journal.Event())
+	}
+	if journal.Err() != nil {
+		fmt.Println("Error while reading journal: " + journal.Err().Error())
+		t.FailNow()
+	}
+	return events
+}
+
+func TestJournalEventFilter(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+
+	events := collectEvents(t, dir, elite.JournalFilter{Events: []string{"FSDJump"}})
+	expected := []string{"Sol", "Alpha Centauri", "Lave"}
+	if len(events) != len(expected) {
+		fmt.Printf("Incorrect number of jumps: Expecting %d, got %d\n", len(expected), len(events))
+		t.FailNow()
+	}
+	for i, event := range events {
+		if jump := event.(*elite.FSDJump); jump.StarSystem != expected[i] {
+			fmt.Printf("Incorrect jump order: Expecting %s, got %s\n", expected[i], jump.StarSystem)
+			t.FailNow()
+		}
+	}
+}
+
+func TestJournalTimeRange(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+
+	events := collectEvents(t, dir, elite.JournalFilter{
+		Events: []string{"FSDJump"},
+		Since:  time.Date(2020, 1, 10, 12, 20, 0, 0, time.UTC),
+		Until:  time.Date(2021, 6, 1, 8, 5, 0, 0, time.UTC),
+	})
+	if len(events) != 1 || events[0].(*elite.FSDJump).StarSystem != "Alpha Centauri" {
+		fmt.Println("Incorrect jumps in time range")
+		t.FailNow()
+	}
+}
+
+func TestJournalCommanderAndMatch(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+
+	gold := func(event elite.Event) bool {
+		return event.(*elite.MarketSell).Type == "gold"
+	}
+
+	events := collectEvents(t, dir, elite.JournalFilter{Events: []string{"MarketSell"}, Match: gold})
+	if len(events) != 2 {
+		fmt.Printf("Incorrect number of gold sales: Expecting 2, got %d\n", len(events))
+		t.FailNow()
+	}
+
+	events = collectEvents(t, dir, elite.JournalFilter{Events: []string{"MarketSell"}, Commander: "Jameson"})
+	if len(events) != 2 || events[1].(*elite.MarketSell).Type != "silver" {
+		fmt.Println("Incorrect sales for commander, including continued journal parts")
+		t.FailNow()
+	}
+}
+
+func TestJournalStrict(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+
+	journal, err := elite.OpenJournal(dir, elite.JournalFilter{})
+	if err != nil {
+		fmt.Println("Couldn't open journal: " + err.Error())
+		t.FailNow()
+	}
+	defer journal.Close()
+	journal.Strict = true
+
+	for journal.Next() {
+	}
+
+	var parseErr *elite.ParseError
+	if !errors.As(journal.Err(), &parseErr) {
+		fmt.Println("Expected a ParseError in strict mode")
+		t.FailNow()
+	}
+	if parseErr.File != "Journal.200110120000.01.log" || parseErr.Line != 5 || parseErr.Text != "not json at all" {
+		fmt.Printf("Incorrect parse error: %s line %d: %q\n", parseErr.File, parseErr.Line, parseErr.Text)
+		t.FailNow()
+	}
+}
+
+func TestErrors(t *testing.T) {
+	dir := writeJournals(t, historyJournals)
+	defer os.RemoveAll(dir)
+
+	if _, err := elite.GetStatisticsFromPath(dir); !errors.Is(err, elite.ErrNotFound) {
+		fmt.Printf("Expected ErrNotFound for missing statistics, got %v\n", err)
+		t.FailNow()
+	}
+
+	missing := filepath.Join(dir, "missing")
+	if _, err := elite.GetLoadoutFromPath(missing); !errors.Is(err, elite.ErrLogDirMissing) {
+		fmt.Printf("Expected ErrLogDirMissing for a missing log path, got %v\n", err)
+		t.FailNow()
+	}
+	if _, err := elite.GetStatusFromPath(missing); !errors.Is(err, elite.ErrLogDirMissing) {
+		fmt.Printf("Expected ErrLogDirMissing for a missing log path, got %v\n", err)
+		t.FailNow()
+	}
+	if _, err := elite.GetMarketFromPath(dir); !errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("Expected a not-exist error for a missing Market.json, got %v\n", err)
+		t.FailNow()
+	}
+}
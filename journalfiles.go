@@ -0,0 +1,254 @@
+package elite
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// JournalFile describes a journal file in the log directory.
+type JournalFile struct {
+	// Name is the file name, without the directory.
+	Name string
+	// Time is when the game started writing the file, taken from its name.
+	// File names are written in the player's local time.
+	Time time.Time
+	// Part is the part number of the file. A new part is started when a
+	// journal file grows too large during a single session.
+	Part int
+}
+
+// journalFileFormat is one of the naming schemes used for journal files over time.
+type journalFileFormat struct {
+	pattern    *regexp.Regexp
+	timeLayout string
+}
+
+var journalFileFormats = []journalFileFormat{
+	// Current format, e.g. Journal.2021-05-20T194034.01.log
+	{regexp.MustCompile(`^Journal\.(\d{4}-\d{2}-\d{2}T\d{6})\.(\d{2})\.log$`), "2006-01-02T150405"},
+	// Format used before Odyssey, e.g. Journal.200117160000.01.log
+	{regexp.MustCompile(`^Journal\.(\d{12})\.(\d{2})\.log$`), "060102150405"},
+}
+
+// ParseJournalFileName parses the timestamp and part number from the name
+// of a journal file. It returns false if the name isn't a journal file name
+// in any known format.
+func ParseJournalFileName(name string) (JournalFile, bool) {
+	for _, format := range journalFileFormats {
+		match := format.pattern.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+
+		t, err := time.ParseInLocation(format.timeLayout, match[1], time.Local)
+		if err != nil {
+			return JournalFile{}, false
+		}
+		part, _ := strconv.Atoi(match[2])
+		return JournalFile{Name: name, Time: t, Part: part}, true
+	}
+
+	return JournalFile{}, false
+}
+
+// ListJournalFiles returns the journal files at the specified log path,
+// ordered from oldest to newest by the time and part number in their names.
+// If the log path doesn't exist, the error wraps ErrLogDirMissing.
+func ListJournalFiles(logPath string) ([]JournalFile, error) {
+	return NewClient(WithLogPath(logPath)).ListJournalFiles()
+}
+
+// ListJournalFiles returns the journal files the Client reads from,
+// ordered from oldest to newest by the time and part number in their names.
+// If the log directory doesn't exist, the error wraps ErrLogDirMissing.
+func (c *Client) ListJournalFiles() ([]JournalFile, error) {
+	files, err := fs.ReadDir(c.fsys, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrLogDirMissing, c.logPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var journals []JournalFile
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		if journal, ok := ParseJournalFileName(file.Name()); ok {
+			journals = append(journals, journal)
+		}
+	}
+
+	sort.SliceStable(journals, func(i, j int) bool {
+		if !journals[i].Time.Equal(journals[j].Time) {
+			return journals[i].Time.Before(journals[j].Time)
+		}
+		return journals[i].Part < journals[j].Part
+	})
+	return journals, nil
+}
+
+// maxLineSize is the longest journal line that can be read.
+// Some events, such as Statistics, are much longer than bufio.Scanner's default limit.
+const maxLineSize = 1024 * 1024
+
+// parseHeader decodes the JournalEntry from a journal line, which is enough
+// to decide whether the rest of the line is worth parsing.
+func parseHeader(line []byte) (*JournalEntry, error) {
+	entry := &JournalEntry{}
+	if err := json.Unmarshal(line, entry); err != nil {
+		return nil, err
+	}
+	if entry.Event == "" {
+		return nil, errors.New("Journal line has no event name")
+	}
+	return entry, nil
+}
+
+// scanJournalFile calls fn with every line in the named journal file, in
+// order, along with its decoded JournalEntry. Lines that can't be decoded
+// are skipped, or reported as a *ParseError in strict mode. An error
+// returned by fn stops the scan and is reported as a *ParseError.
+func (c *Client) scanJournalFile(name string, fn func(line []byte, entry *JournalEntry) error) error {
+	journalFile, err := c.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer journalFile.Close()
+
+	scanner := bufio.NewScanner(journalFile)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry, err := parseHeader(line)
+		if err != nil {
+			if c.strict {
+				return &ParseError{File: name, Line: lineNumber, Text: string(line), Err: err}
+			}
+			c.logf("Skipping %s line %d: %v", name, lineNumber, err)
+			continue
+		}
+		if err := fn(line, entry); err != nil {
+			return &ParseError{File: name, Line: lineNumber, Text: string(line), Err: err}
+		}
+	}
+	return scanner.Err()
+}
+
+// forEachEvent calls fn with every event in the named journal file, in order.
+// Lines that can't be parsed are skipped, or reported as a *ParseError in
+// strict mode.
+func (c *Client) forEachEvent(name string, fn func(Event)) error {
+	return c.scanJournalFile(name, func(line []byte, entry *JournalEntry) error {
+		event, err := ParseEvent(line)
+		if err != nil {
+			if c.strict {
+				return err
+			}
+			c.logf("Skipping %s event in %s: %v", entry.Event, name, err)
+			return nil
+		}
+		fn(event)
+		return nil
+	})
+}
+
+// findLastLine returns the line of the most recent event with one of the
+// given names in the journal files. Only the newest file containing a
+// matching event is read in full. If there are no matching events, the
+// error wraps ErrNotFound.
+func (c *Client) findLastLine(names ...string) ([]byte, error) {
+	files, err := c.ListJournalFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	for i := len(files) - 1; i >= 0; i-- {
+		var last []byte
+		err := c.scanJournalFile(files[i].Name, func(line []byte, entry *JournalEntry) error {
+			if wanted[entry.Event] {
+				last = append(last[:0], line...)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if last != nil {
+			return last, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// lastCommander returns the name of the commander who played most recently,
+// or an empty string if no commander has been loaded.
+func (c *Client) lastCommander() (string, error) {
+	line, err := c.findLastLine("Commander", "LoadGame")
+	if errors.Is(err, ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	event, err := ParseEvent(line)
+	if err != nil {
+		return "", err
+	}
+	switch e := event.(type) {
+	case *Commander:
+		return e.Name, nil
+	case *LoadGame:
+		return e.Commander, nil
+	}
+	return "", nil
+}
+
+// openAt opens the named journal file and moves to offset. Files that can't
+// seek, such as those in a zip archive, are read up to offset instead.
+func (c *Client) openAt(name string, offset int64) (fs.File, error) {
+	file, err := c.fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := skipTo(file, offset); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// skipTo moves file to offset, discarding what comes before it if the file can't seek.
+func skipTo(file fs.File, offset int64) error {
+	if seeker, ok := file.(io.Seeker); ok {
+		_, err := seeker.Seek(offset, io.SeekStart)
+		return err
+	}
+	_, err := io.CopyN(ioutil.Discard, file, offset)
+	return err
+}
@@ -1,17 +1,15 @@
 package elite
 
 import (
-	"bufio"
-	"encoding/json"
 	"errors"
-	"io/ioutil"
-	"os"
-	"path/filepath"
+	"fmt"
 
+	"github.com/BenJuan26/elite/catalogue"
 	"github.com/BenJuan26/elite/loadout"
 )
 
 // Loadout contains information about the player's ship, its status, and its modules.
+// HullHealth is a fraction from 0 to 1, and Hot is only written for wanted ships.
 type Loadout struct {
 	*JournalEntry
 	Ship          string           `json:"Ship"`
@@ -20,47 +18,77 @@ type Loadout struct {
 	ShipIdent     string           `json:"ShipIdent"`
 	HullValue     int64            `json:"HullValue"`
 	ModulesValue  int64            `json:"ModulesValue"`
-	HullHealth    int64            `json:"HullHealth"`
+	HullHealth    float64          `json:"HullHealth"`
 	UnladenMass   float64          `json:"UnladenMass"`
 	CargoCapacity int64            `json:"CargoCapacity"`
 	MaxJumpRange  float64          `json:"MaxJumpRange"`
 	FuelCapacity  loadout.Fuel     `json:"FuelCapacity"`
 	Rebuy         int64            `json:"Rebuy"`
+	Hot           bool             `json:"Hot,omitempty"`
 	Modules       []loadout.Module `json:"Modules"`
 }
 
-// GetLoadoutFromPath reads the current ship loadout from the journal files at the specified path.
-func GetLoadoutFromPath(logPath string) (*Loadout, error) {
-	files, _ := ioutil.ReadDir(logPath)
+// ShipInfo decodes the ship's symbol, giving its display name and landing
+// pad size. It returns false if the ship isn't in the catalogue.
+func (l *Loadout) ShipInfo() (catalogue.Ship, bool) {
+	return catalogue.LookupShip(l.Ship)
+}
+
+// EngineeredModules returns the engineered modules on the ship grouped by
+// the name of the engineer who applied the blueprint.
+func (l *Loadout) EngineeredModules() map[string][]loadout.Module {
+	return loadout.ByEngineer(l.Modules)
+}
 
-	found := false
-	var l *Loadout
-	for i := len(files) - 1; i >= 0 && !found; i-- {
-		if !journalFilePattern.MatchString(files[i].Name()) {
-			continue
-		}
+// FSD returns the jump performance of the ship's frame shift drive,
+// including its engineering and any Guardian FSD Booster.
+func (l *Loadout) FSD() (loadout.FSD, error) {
+	return loadout.FindFSD(l.Modules)
+}
 
-		journalFile, err := os.Open(filepath.Join(logPath, files[i].Name()))
-		if err != nil {
-			return l, err
-		}
-		defer journalFile.Close()
+// PowerBudget returns the power used by the ship's modules in each priority
+// group, compared to the power its power plant supplies. The totals leave
+// out any modules whose power draw isn't known; see PowerBudget.Complete.
+func (l *Loadout) PowerBudget() (loadout.PowerBudget, error) {
+	return loadout.NewPowerBudget(l.Modules)
+}
 
-		scanner := bufio.NewScanner(journalFile)
-		for scanner.Scan() {
-			var tempEvent Loadout
-			json.Unmarshal(scanner.Bytes(), &tempEvent)
-			if tempEvent.Event == "Loadout" {
-				l = &tempEvent
-				found = true
-			}
-		}
+// JumpRange returns the distance in light years the ship can jump with the
+// given fuel in the main tank and cargo on board, in tonnes. The mass
+// includes a full reserve tank, which the game leaves out of MaxJumpRange,
+// so with just enough fuel for one jump and no cargo the range is slightly
+// shorter than MaxJumpRange. It returns an error if fuel is negative or more
+// than the main tank holds.
+func (l *Loadout) JumpRange(fuel, cargo float64) (float64, error) {
+	if fuel < 0 || fuel > l.FuelCapacity.Main {
+		return 0, fmt.Errorf("Fuel must be between 0 and %g tonnes, got %g", l.FuelCapacity.Main, fuel)
+	}
+	fsd, err := l.FSD()
+	if err != nil {
+		return 0, err
 	}
+	return fsd.JumpRange(l.UnladenMass+l.FuelCapacity.Reserve+fuel+cargo, fuel), nil
+}
+
+// GetLoadoutFromPath reads the current ship loadout from the journal files at the specified path.
+func GetLoadoutFromPath(logPath string) (*Loadout, error) {
+	return NewClient(WithLogPath(logPath)).GetLoadout()
+}
 
-	if !found {
-		return l, errors.New("No loadout found in all log files")
+// GetLoadout reads the current ship loadout from the journal files.
+func (c *Client) GetLoadout() (*Loadout, error) {
+	line, err := c.findLastLine("Loadout")
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("Couldn't get loadout: %w", err)
+	}
+	if err != nil {
+		return nil, err
 	}
 
+	l := &Loadout{}
+	if err := decodeEvent(line, l); err != nil {
+		return nil, err
+	}
 	return l, nil
 }
 
@@ -72,5 +100,5 @@ func GetLoadoutFromPath(logPath string) (*Loadout, error) {
 //
 // If that path is not suitable, use GetLoadoutFromPath.
 func GetLoadout() (*Loadout, error) {
-	return GetLoadoutFromPath(defaultLogPath)
+	return GetLoadoutFromPath(defaultLogPath())
 }
@@ -0,0 +1,18 @@
+package loadout
+
+import "github.com/BenJuan26/elite/catalogue"
+
+// Info decodes the module's Item, giving its category, size, rating,
+// display name and, where known, base stats. Internal modules take their
+// category from the slot they're fitted to, since some, such as fuel tanks,
+// fit both core and optional slots. It returns false if the kind of module
+// isn't in the catalogue.
+func (m Module) Info() (catalogue.Item, bool) {
+	item, ok := catalogue.Lookup(m.Item)
+	if item.Category == catalogue.CategoryCore || item.Category == catalogue.CategoryOptional {
+		if category, known := catalogue.SlotCategory(m.Slot); known {
+			item.Category = category
+		}
+	}
+	return item, ok
+}
@@ -0,0 +1,96 @@
+package loadout
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Change returns the percentage change from the original value of the
+// modifier to its engineered value. It returns zero if the original value is zero.
+// An increase is positive even when the original value is negative, as some
+// armour resistances are.
+func (m Modifier) Change() float64 {
+	if m.OriginalValue == 0 {
+		return 0
+	}
+	return (m.Value - m.OriginalValue) / math.Abs(m.OriginalValue) * 100
+}
+
+// IsBuff reports whether the modifier improves on the original value,
+// taking into account whether less is better.
+func (m Modifier) IsBuff() bool {
+	if m.LessIsGood != 0 {
+		return m.Value < m.OriginalValue
+	}
+	return m.Value > m.OriginalValue
+}
+
+// IsNerf reports whether the modifier is worse than the original value,
+// taking into account whether less is better.
+func (m Modifier) IsNerf() bool {
+	return m.Value != m.OriginalValue && !m.IsBuff()
+}
+
+// IsEngineered reports whether a blueprint has been applied.
+func (e Engineering) IsEngineered() bool {
+	return e.BlueprintName != ""
+}
+
+// Buffs returns the modifiers that improve on the original values.
+func (e Engineering) Buffs() []Modifier {
+	var buffs []Modifier
+	for _, m := range e.Modifiers {
+		if m.IsBuff() {
+			buffs = append(buffs, m)
+		}
+	}
+	return buffs
+}
+
+// Nerfs returns the modifiers that are worse than the original values.
+func (e Engineering) Nerfs() []Modifier {
+	var nerfs []Modifier
+	for _, m := range e.Modifiers {
+		if m.IsNerf() {
+			nerfs = append(nerfs, m)
+		}
+	}
+	return nerfs
+}
+
+// Summary describes the blueprint, grade and experimental effect, such as
+// "FSD_LongRange G5 (Mass Manager)". It returns an empty string if the
+// module isn't engineered.
+func (e Engineering) Summary() string {
+	if !e.IsEngineered() {
+		return ""
+	}
+
+	summary := fmt.Sprintf("%s G%d", e.BlueprintName, e.Level)
+	effect := e.ExperimentalEffectLocalised
+	if effect == "" {
+		effect = e.ExperimentalEffect
+	}
+	if effect != "" {
+		summary += " (" + effect + ")"
+	}
+	return summary
+}
+
+// ByEngineer returns the engineered modules grouped by the name of the
+// engineer who applied the blueprint, each group ordered by slot.
+func ByEngineer(modules []Module) map[string][]Module {
+	engineers := map[string][]Module{}
+	for _, module := range modules {
+		if module.Engineering.IsEngineered() {
+			engineers[module.Engineering.Engineer] = append(engineers[module.Engineering.Engineer], module)
+		}
+	}
+	for _, group := range engineers {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].Slot < group[j].Slot
+		})
+	}
+	return engineers
+}
@@ -0,0 +1,162 @@
+package loadout
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// FSD describes the jump performance of a frame shift drive.
+type FSD struct {
+	// Class is the size of the drive, from 2 to 7.
+	Class int
+	// Rating is the grade of the drive, from "E" to "A".
+	Rating string
+	// OptimalMass is the mass in tonnes the drive is optimised for,
+	// including any engineering.
+	OptimalMass float64
+	// MaxFuelPerJump is the most fuel in tonnes a single jump can use.
+	MaxFuelPerJump float64
+	// LinearConstant and PowerConstant define how fuel use grows with distance.
+	LinearConstant float64
+	PowerConstant  float64
+	// GuardianBonus is the range in light years added by a Guardian FSD Booster.
+	GuardianBonus float64
+	// Overcharge is set for drives with supercruise overcharge (SCO).
+	Overcharge bool
+}
+
+// fsdStats are the optimal mass and maximum fuel per jump of each drive, by class and rating.
+var fsdStats = map[int]map[string][2]float64{
+	2: {"E": {48, 0.6}, "D": {54, 0.6}, "C": {60, 0.6}, "B": {75, 0.8}, "A": {90, 0.9}},
+	3: {"E": {80, 1.2}, "D": {90, 1.2}, "C": {100, 1.2}, "B": {125, 1.5}, "A": {150, 1.8}},
+	4: {"E": {280, 2.0}, "D": {315, 2.0}, "C": {350, 2.0}, "B": {438, 2.5}, "A": {525, 3.0}},
+	5: {"E": {560, 3.3}, "D": {630, 3.3}, "C": {700, 3.3}, "B": {875, 4.1}, "A": {1050, 5.0}},
+	6: {"E": {960, 5.3}, "D": {1080, 5.3}, "C": {1200, 5.3}, "B": {1500, 6.6}, "A": {1800, 8.0}},
+	7: {"E": {1440, 8.5}, "D": {1620, 8.5}, "C": {1800, 8.5}, "B": {2250, 10.6}, "A": {2700, 12.8}},
+}
+
+// overchargeFSDStats are the optimal mass, maximum fuel per jump and fuel
+// linear constant of each supercruise overcharge drive, by class and rating.
+// Only the A-rated drives are known.
+var overchargeFSDStats = map[int]map[string][3]float64{
+	2: {"A": {100, 1.0, 13}},
+	3: {"A": {167, 1.9, 13}},
+	4: {"A": {585, 3.2, 13}},
+	5: {"A": {1175, 5.2, 13}},
+	6: {"A": {2000, 8.3, 13}},
+	7: {"A": {3000, 13.1, 13}},
+}
+
+// fsdLinearConstants are the fuel linear constants of each rating.
+var fsdLinearConstants = map[string]float64{"E": 11, "D": 10, "C": 8, "B": 10, "A": 12}
+
+// fsdPowerConstants are the fuel power constants of each class.
+var fsdPowerConstants = map[int]float64{2: 2.00, 3: 2.15, 4: 2.30, 5: 2.45, 6: 2.60, 7: 2.75}
+
+// guardianBonuses are the range added by each size of Guardian FSD Booster.
+var guardianBonuses = map[int]float64{1: 4.00, 2: 6.00, 3: 7.75, 4: 9.25, 5: 10.50}
+
+// Module items are named like int_hyperdrive_size5_class5, where the
+// "class" in the name is the rating, with 1 being E and 5 being A.
+var (
+	fsdItemPattern      = regexp.MustCompile(`(?i)^int_hyperdrive(_overcharge)?_size(\d)_class(\d)$`)
+	guardianItemPattern = regexp.MustCompile(`(?i)^int_guardianfsdbooster_size(\d)$`)
+)
+
+var ratings = [...]string{"E", "D", "C", "B", "A"}
+
+// NewFSD returns the unengineered stats of the frame shift drive with the
+// given module item, such as "int_hyperdrive_size5_class5" or
+// "int_hyperdrive_overcharge_size5_class5".
+func NewFSD(item string) (FSD, error) {
+	match := fsdItemPattern.FindStringSubmatch(item)
+	if match == nil {
+		return FSD{}, errors.New("Not a frame shift drive: " + item)
+	}
+
+	class, _ := strconv.Atoi(match[2])
+	grade, _ := strconv.Atoi(match[3])
+	if grade < 1 || grade > len(ratings) {
+		return FSD{}, errors.New("Unknown frame shift drive: " + item)
+	}
+	rating := ratings[grade-1]
+	fsd := FSD{
+		Class:         class,
+		Rating:        rating,
+		PowerConstant: fsdPowerConstants[class],
+		Overcharge:    match[1] != "",
+	}
+
+	if fsd.Overcharge {
+		stats, ok := overchargeFSDStats[class][rating]
+		if !ok {
+			return FSD{}, errors.New("Unknown frame shift drive: " + item)
+		}
+		fsd.OptimalMass, fsd.MaxFuelPerJump, fsd.LinearConstant = stats[0], stats[1], stats[2]
+		return fsd, nil
+	}
+
+	stats, ok := fsdStats[class][rating]
+	if !ok {
+		return FSD{}, errors.New("Unknown frame shift drive: " + item)
+	}
+	fsd.OptimalMass, fsd.MaxFuelPerJump = stats[0], stats[1]
+	fsd.LinearConstant = fsdLinearConstants[rating]
+	return fsd, nil
+}
+
+// FindFSD returns the stats of the frame shift drive fitted among modules,
+// including its engineering and any Guardian FSD Booster.
+func FindFSD(modules []Module) (FSD, error) {
+	var fsd FSD
+	found := false
+	bonus := 0.0
+	for _, module := range modules {
+		if match := guardianItemPattern.FindStringSubmatch(module.Item); match != nil {
+			size, _ := strconv.Atoi(match[1])
+			bonus = guardianBonuses[size]
+			continue
+		}
+		if module.Slot != "FrameShiftDrive" {
+			continue
+		}
+
+		var err error
+		if fsd, err = NewFSD(module.Item); err != nil {
+			return FSD{}, err
+		}
+		fsd.ApplyEngineering(module.Engineering)
+		found = true
+	}
+
+	if !found {
+		return FSD{}, errors.New("No frame shift drive fitted")
+	}
+	fsd.GuardianBonus = bonus
+	return fsd, nil
+}
+
+// ApplyEngineering applies the engineered values of a blueprint to the drive.
+func (fsd *FSD) ApplyEngineering(e Engineering) {
+	for _, m := range e.Modifiers {
+		switch m.Label {
+		case "FSDOptimalMass":
+			fsd.OptimalMass = m.Value
+		case "MaxFuelPerJump":
+			fsd.MaxFuelPerJump = m.Value
+		}
+	}
+}
+
+// JumpRange returns the distance in light years of a single jump by a ship
+// with the given total mass in tonnes, using at most fuel tonnes of fuel.
+// The mass should include the fuel and cargo on board.
+func (fsd FSD) JumpRange(mass, fuel float64) float64 {
+	if mass <= 0 || fuel <= 0 || fsd.LinearConstant == 0 || fsd.PowerConstant == 0 {
+		return 0
+	}
+	fuel = math.Min(fuel, fsd.MaxFuelPerJump)
+	return fsd.OptimalMass/mass*math.Pow(fuel*1000/fsd.LinearConstant, 1/fsd.PowerConstant) + fsd.GuardianBonus
+}
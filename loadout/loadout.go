@@ -1,37 +1,74 @@
 package loadout
 
+import "encoding/json"
+
 // Fuel contains the main and reserve fuel capacities.
+//
+// The field is named Reserve after the journal's FuelCapacity, while the
+// Status file calls the same tank FuelReservoir. Reserve is kept for
+// compatibility with existing callers; Reservoir gives it the name used by
+// elite.Fuel.
 type Fuel struct {
 	Main    float64 `json:"Main"`
 	Reserve float64 `json:"Reserve"`
 }
 
+// Reservoir returns the capacity of the reserve tank, matching the name of
+// elite.Fuel.Reservoir.
+func (f Fuel) Reservoir() float64 {
+	return f.Reserve
+}
+
 // Module contains information about a ship module.
+// Health is a fraction from 0 to 1. AmmoInClip and AmmoInHopper are only
+// written for weapons, so they are nil for other modules, and Value is left
+// out for modules fitted with the ship.
 type Module struct {
-	Slot        string      `json:"Slot"`
-	Item        string      `json:"Item"`
-	On          bool        `json:"On"`
-	Priority    int64       `json:"Priority"`
-	Health      int64       `json:"Health"`
-	Engineering Engineering `json:"Engineering"`
+	Slot         string      `json:"Slot"`
+	Item         string      `json:"Item"`
+	On           bool        `json:"On"`
+	Priority     int64       `json:"Priority"`
+	AmmoInClip   *int64      `json:"AmmoInClip,omitempty"`
+	AmmoInHopper *int64      `json:"AmmoInHopper,omitempty"`
+	Health       float64     `json:"Health"`
+	Value        int64       `json:"Value,omitempty"`
+	Engineering  Engineering `json:"Engineering"`
+}
+
+// MarshalJSON writes the module as the journal does, leaving out the
+// Engineering of modules that aren't engineered.
+func (m Module) MarshalJSON() ([]byte, error) {
+	type module Module
+	out := struct {
+		module
+		Engineering *Engineering `json:"Engineering,omitempty"`
+	}{module: module(m)}
+	if m.Engineering.IsEngineered() {
+		out.Engineering = &m.Engineering
+	}
+	return json.Marshal(out)
 }
 
-// Modifier describes an engineering modifier.
+// Modifier describes an engineering modifier. Most modifiers are numeric;
+// the few that aren't, such as a weapon's fire mode, set ValueStr instead.
 type Modifier struct {
-	Label         string  `json:"Label"`
-	Value         float64 `json:"Value"`
-	OriginalValue float64 `json:"OriginalValue"`
-	LessIsGood    int64   `json:"LessIsGood"`
+	Label             string  `json:"Label"`
+	Value             float64 `json:"Value"`
+	OriginalValue     float64 `json:"OriginalValue"`
+	LessIsGood        int64   `json:"LessIsGood"`
+	ValueStr          string  `json:"ValueStr,omitempty"`
+	ValueStrLocalised string  `json:"ValueStr_Localised,omitempty"`
 }
 
 // Engineering represents the engineering modifications performed on a module.
 type Engineering struct {
-	Engineer           string     `json:"Engineer"`
-	EngineerID         int64      `json:"EngineerID"`
-	BlueprintID        int64      `json:"BlueprintID"`
-	BlueprintName      string     `json:"BlueprintName"`
-	Level              int64      `json:"Level"`
-	Quality            float64    `json:"Quality"`
-	ExperimentalEffect string     `json:"ExperimentalEffect"`
-	Modifiers          []Modifier `json:"Modifiers"`
+	Engineer                    string     `json:"Engineer"`
+	EngineerID                  int64      `json:"EngineerID"`
+	BlueprintID                 int64      `json:"BlueprintID"`
+	BlueprintName               string     `json:"BlueprintName"`
+	Level                       int64      `json:"Level"`
+	Quality                     float64    `json:"Quality"`
+	ExperimentalEffect          string     `json:"ExperimentalEffect"`
+	ExperimentalEffectLocalised string     `json:"ExperimentalEffect_Localised"`
+	Modifiers                   []Modifier `json:"Modifiers"`
 }
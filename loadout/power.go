@@ -0,0 +1,216 @@
+package loadout
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/BenJuan26/elite/catalogue"
+)
+
+// PowerGroup is the power used by the modules in one priority group.
+type PowerGroup struct {
+	// Priority is the group as written in the journal, from 0 to 4. The
+	// game shows it as 1 to 5.
+	Priority int64
+	// Retracted and Deployed are the power in megawatts used by the group
+	// with hardpoints retracted and deployed.
+	Retracted float64
+	Deployed  float64
+	Modules   []Module
+}
+
+// PowerBudget compares the power used by a ship's modules to the power
+// its power plant can supply.
+type PowerBudget struct {
+	// Capacity is the power in megawatts the power plant supplies,
+	// including any engineering.
+	Capacity float64
+	// Retracted and Deployed are the total power in megawatts used with
+	// hardpoints retracted and deployed. Modules that are switched off
+	// aren't counted.
+	Retracted float64
+	Deployed  float64
+	// Groups holds the five priority groups in order.
+	Groups [5]PowerGroup
+	// Unknown holds the modules that are switched on but whose power draw
+	// isn't known. They aren't counted in any of the totals, so the budget
+	// is incomplete unless Unknown is empty; see Complete.
+	Unknown []Module
+	// HeatEfficiency is the heat the power plant generates per megawatt
+	// used, including any engineering.
+	HeatEfficiency float64
+}
+
+// powerCapacities are the power in megawatts supplied by each power plant, by class and rating.
+var powerCapacities = map[int]map[string]float64{
+	2: {"E": 6.4, "D": 7.2, "C": 8.0, "B": 8.8, "A": 9.6},
+	3: {"E": 8.0, "D": 9.0, "C": 10.0, "B": 11.0, "A": 12.0},
+	4: {"E": 10.4, "D": 11.7, "C": 13.0, "B": 14.3, "A": 15.6},
+	5: {"E": 13.6, "D": 15.3, "C": 17.0, "B": 18.7, "A": 20.4},
+	6: {"E": 16.8, "D": 18.9, "C": 21.0, "B": 23.1, "A": 25.2},
+	7: {"E": 20.0, "D": 22.5, "C": 25.0, "B": 27.5, "A": 30.0},
+	8: {"E": 24.0, "D": 27.0, "C": 30.0, "B": 33.0, "A": 36.0},
+}
+
+// guardianPowerCapacities are the power in megawatts supplied by each Guardian Hybrid Power Plant, by class.
+var guardianPowerCapacities = map[int]float64{2: 12.7, 3: 15.8, 4: 20.6, 5: 26.9, 6: 33.3, 7: 39.6, 8: 42.9}
+
+// heatEfficiencies are the heat generated per megawatt used by each power plant, by rating.
+var heatEfficiencies = map[string]float64{"E": 1.0, "D": 0.5, "C": 0.5, "B": 0.45, "A": 0.4}
+
+// guardianHeatEfficiency is the heat generated per megawatt used by a Guardian Hybrid Power Plant.
+const guardianHeatEfficiency = 0.5
+
+var (
+	powerPlantItemPattern         = regexp.MustCompile(`(?i)^int_powerplant_size(\d)_class(\d)$`)
+	guardianPowerPlantItemPattern = regexp.MustCompile(`(?i)^int_guardianpowerplant_size(\d)$`)
+)
+
+// NewPowerBudget works out the power budget of a ship fitted with modules.
+// It returns an error if no power plant is fitted, or its capacity isn't known.
+func NewPowerBudget(modules []Module) (PowerBudget, error) {
+	var budget PowerBudget
+	for i := range budget.Groups {
+		budget.Groups[i].Priority = int64(i)
+	}
+
+	found := false
+	for _, module := range modules {
+		if module.Slot == "PowerPlant" {
+			capacity, efficiency, err := powerPlantStats(module)
+			if err != nil {
+				return PowerBudget{}, err
+			}
+			budget.Capacity = capacity
+			budget.HeatEfficiency = efficiency
+			found = true
+			continue
+		}
+		if !module.On {
+			continue
+		}
+
+		info, _ := module.Info()
+		draw, ok := info.PowerDraw()
+		if value, engineered := modifier(module.Engineering, "PowerDraw"); engineered {
+			draw, ok = value, true
+		}
+		if !ok {
+			budget.Unknown = append(budget.Unknown, module)
+			continue
+		}
+
+		priority := module.Priority
+		if priority < 0 || priority >= int64(len(budget.Groups)) {
+			priority = 0
+		}
+		group := &budget.Groups[priority]
+		group.Modules = append(group.Modules, module)
+		group.Deployed += draw
+		budget.Deployed += draw
+		// Weapons only draw power while hardpoints are deployed.
+		if info.Category != catalogue.CategoryHardpoint {
+			group.Retracted += draw
+			budget.Retracted += draw
+		}
+	}
+
+	if !found {
+		return PowerBudget{}, errors.New("No power plant fitted")
+	}
+	return budget, nil
+}
+
+// powerPlantStats returns the power supplied by a power plant and its heat
+// efficiency, including its engineering.
+func powerPlantStats(module Module) (float64, float64, error) {
+	var capacity, efficiency float64
+	if match := guardianPowerPlantItemPattern.FindStringSubmatch(module.Item); match != nil {
+		class, _ := strconv.Atoi(match[1])
+		capacity = guardianPowerCapacities[class]
+		efficiency = guardianHeatEfficiency
+	} else if match := powerPlantItemPattern.FindStringSubmatch(module.Item); match != nil {
+		class, _ := strconv.Atoi(match[1])
+		grade, _ := strconv.Atoi(match[2])
+		if grade >= 1 && grade <= len(ratings) {
+			capacity = powerCapacities[class][ratings[grade-1]]
+			efficiency = heatEfficiencies[ratings[grade-1]]
+		}
+	}
+
+	if value, ok := modifier(module.Engineering, "PowerCapacity"); ok {
+		capacity = value
+	}
+	if value, ok := modifier(module.Engineering, "HeatEfficiency"); ok {
+		efficiency = value
+	}
+	if capacity == 0 {
+		return 0, 0, errors.New("Unknown power plant: " + module.Item)
+	}
+	return capacity, efficiency, nil
+}
+
+// modifier returns the engineered value with the given label, if there is one.
+func modifier(e Engineering, label string) (float64, bool) {
+	for _, m := range e.Modifiers {
+		if m.Label == label {
+			return m.Value, true
+		}
+	}
+	return 0, false
+}
+
+// Complete reports whether the power draw of every module switched on is
+// known. If it isn't, the totals leave out the modules in Unknown.
+func (budget PowerBudget) Complete() bool {
+	return len(budget.Unknown) == 0
+}
+
+// Overloaded reports whether the ship uses more power than its power plant
+// supplies, with hardpoints deployed or retracted.
+func (budget PowerBudget) Overloaded(deployed bool) bool {
+	return budget.used(deployed) > budget.Capacity
+}
+
+// ShutDown returns the modules the game would switch off to stay within
+// the power plant's capacity, with hardpoints deployed or retracted. Whole
+// priority groups are switched off, starting with the last, until the rest
+// fit within the capacity.
+func (budget PowerBudget) ShutDown(deployed bool) []Module {
+	var modules []Module
+	used := 0.0
+	for _, group := range budget.Groups {
+		if deployed {
+			used += group.Deployed
+		} else {
+			used += group.Retracted
+		}
+		if used > budget.Capacity {
+			modules = append(modules, group.Modules...)
+		}
+	}
+	return modules
+}
+
+// Utilisation returns the fraction of the power plant's capacity in use,
+// with hardpoints deployed or retracted.
+func (budget PowerBudget) Utilisation(deployed bool) float64 {
+	if budget.Capacity == 0 {
+		return 0
+	}
+	return budget.used(deployed) / budget.Capacity
+}
+
+// Heat returns the heat the power plant generates supplying the ship's
+// modules, with hardpoints deployed or retracted.
+func (budget PowerBudget) Heat(deployed bool) float64 {
+	return budget.used(deployed) * budget.HeatEfficiency
+}
+
+func (budget PowerBudget) used(deployed bool) float64 {
+	if deployed {
+		return budget.Deployed
+	}
+	return budget.Retracted
+}
@@ -0,0 +1,73 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// MarketItem is a commodity listed in a station's market.
+type MarketItem struct {
+	ID                int64  `json:"id"`
+	Name              string `json:"Name"`
+	NameLocalised     string `json:"Name_Localised"`
+	Category          string `json:"Category"`
+	CategoryLocalised string `json:"Category_Localised"`
+	BuyPrice          int64  `json:"BuyPrice"`
+	SellPrice         int64  `json:"SellPrice"`
+	MeanPrice         int64  `json:"MeanPrice"`
+	StockBracket      int64  `json:"StockBracket"`
+	DemandBracket     int64  `json:"DemandBracket"`
+	Stock             int64  `json:"Stock"`
+	Demand            int64  `json:"Demand"`
+	Consumer          bool   `json:"Consumer"`
+	Producer          bool   `json:"Producer"`
+	Rare              bool   `json:"Rare"`
+}
+
+// Market describes a station's commodity market. It is written when the
+// player opens the market; the journal event only identifies the station,
+// while Market.json also lists the Items.
+type Market struct {
+	*JournalEntry
+	MarketID    int64        `json:"MarketID"`
+	StationName string       `json:"StationName"`
+	StationType string       `json:"StationType"`
+	StarSystem  string       `json:"StarSystem"`
+	Items       []MarketItem `json:"Items,omitempty"`
+}
+
+// GetMarket reads the commodity market of the station last visited from Market.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetMarketFromPath.
+func GetMarket() (*Market, error) {
+	return GetMarketFromPath(defaultLogPath())
+}
+
+// GetMarketFromPath reads the commodity market of the station last visited from Market.json at the specified log path.
+func GetMarketFromPath(logPath string) (*Market, error) {
+	return NewClient(WithLogPath(logPath)).GetMarket()
+}
+
+// GetMarket reads the commodity market of the station last visited from Market.json.
+func (c *Client) GetMarket() (*Market, error) {
+	var market *Market
+	err := c.readCompanionFile("Market.json", func(content []byte) (err error) {
+		market, err = GetMarketFromBytes(content)
+		return err
+	})
+	return market, err
+}
+
+// GetMarketFromBytes reads the commodity market of the station last visited from the string contained in the byte array.
+func GetMarketFromBytes(content []byte) (*Market, error) {
+	market := &Market{}
+	if err := json.Unmarshal(content, market); err != nil {
+		return nil, errors.New("Couldn't unmarshal Market.json file: " + err.Error())
+	}
+
+	return market, nil
+}
@@ -0,0 +1,58 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ModuleInfo is the power usage of a single fitted module.
+type ModuleInfo struct {
+	Slot     string  `json:"Slot"`
+	Item     string  `json:"Item"`
+	Power    float64 `json:"Power"`
+	Priority int64   `json:"Priority"`
+}
+
+// ModulesInfo is written as the ModuleInfo event when the player views the
+// modules panel. The journal event has no fields, while ModulesInfo.json
+// lists the Modules.
+type ModulesInfo struct {
+	*JournalEntry
+	Modules []ModuleInfo `json:"Modules,omitempty"`
+}
+
+// GetModulesInfo reads the power usage of the ship's modules from ModulesInfo.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetModulesInfoFromPath.
+func GetModulesInfo() (*ModulesInfo, error) {
+	return GetModulesInfoFromPath(defaultLogPath())
+}
+
+// GetModulesInfoFromPath reads the power usage of the ship's modules from ModulesInfo.json at the specified log path.
+func GetModulesInfoFromPath(logPath string) (*ModulesInfo, error) {
+	return NewClient(WithLogPath(logPath)).GetModulesInfo()
+}
+
+// GetModulesInfo reads the power usage of the ship's modules from ModulesInfo.json.
+func (c *Client) GetModulesInfo() (*ModulesInfo, error) {
+	var info *ModulesInfo
+	err := c.readCompanionFile("ModulesInfo.json", func(content []byte) (err error) {
+		info, err = GetModulesInfoFromBytes(content)
+		return err
+	})
+	return info, err
+}
+
+// GetModulesInfoFromBytes reads the power usage of the ship's modules from the string contained in the byte array.
+func GetModulesInfoFromBytes(content []byte) (*ModulesInfo, error) {
+	info := &ModulesInfo{}
+	if err := json.Unmarshal(content, info); err != nil {
+		return nil, errors.New("Couldn't unmarshal ModulesInfo.json file: " + err.Error())
+	}
+
+	return info, nil
+}
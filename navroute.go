@@ -0,0 +1,58 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// NavRouteEntry is a single star system along a plotted route.
+type NavRouteEntry struct {
+	StarSystem    string     `json:"StarSystem"`
+	SystemAddress int64      `json:"SystemAddress"`
+	StarPos       [3]float64 `json:"StarPos"`
+	StarClass     string     `json:"StarClass"`
+}
+
+// NavRoute is written when a route is plotted in the galaxy map. The journal
+// event has no fields, while NavRoute.json lists every system in the Route,
+// starting with the current one.
+type NavRoute struct {
+	*JournalEntry
+	Route []NavRouteEntry `json:"Route,omitempty"`
+}
+
+// GetNavRoute reads the route plotted in the galaxy map from NavRoute.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetNavRouteFromPath.
+func GetNavRoute() (*NavRoute, error) {
+	return GetNavRouteFromPath(defaultLogPath())
+}
+
+// GetNavRouteFromPath reads the route plotted in the galaxy map from NavRoute.json at the specified log path.
+func GetNavRouteFromPath(logPath string) (*NavRoute, error) {
+	return NewClient(WithLogPath(logPath)).GetNavRoute()
+}
+
+// GetNavRoute reads the route plotted in the galaxy map from NavRoute.json.
+func (c *Client) GetNavRoute() (*NavRoute, error) {
+	var route *NavRoute
+	err := c.readCompanionFile("NavRoute.json", func(content []byte) (err error) {
+		route, err = GetNavRouteFromBytes(content)
+		return err
+	})
+	return route, err
+}
+
+// GetNavRouteFromBytes reads the route plotted in the galaxy map from the string contained in the byte array.
+func GetNavRouteFromBytes(content []byte) (*NavRoute, error) {
+	route := &NavRoute{}
+	if err := json.Unmarshal(content, route); err != nil {
+		return nil, errors.New("Couldn't unmarshal NavRoute.json file: " + err.Error())
+	}
+
+	return route, nil
+}
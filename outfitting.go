@@ -0,0 +1,61 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// OutfittingItem is a module for sale in a station's outfitting.
+type OutfittingItem struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"Name"`
+	BuyPrice int64  `json:"BuyPrice"`
+}
+
+// Outfitting describes the modules for sale at a station. It is written when
+// the player opens outfitting; the journal event only identifies the station,
+// while Outfitting.json also lists the Items.
+type Outfitting struct {
+	*JournalEntry
+	MarketID    int64            `json:"MarketID"`
+	StationName string           `json:"StationName"`
+	StarSystem  string           `json:"StarSystem"`
+	Horizons    bool             `json:"Horizons"`
+	Items       []OutfittingItem `json:"Items,omitempty"`
+}
+
+// GetOutfitting reads the modules for sale at the station last visited from Outfitting.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetOutfittingFromPath.
+func GetOutfitting() (*Outfitting, error) {
+	return GetOutfittingFromPath(defaultLogPath())
+}
+
+// GetOutfittingFromPath reads the modules for sale at the station last visited from Outfitting.json at the specified log path.
+func GetOutfittingFromPath(logPath string) (*Outfitting, error) {
+	return NewClient(WithLogPath(logPath)).GetOutfitting()
+}
+
+// GetOutfitting reads the modules for sale at the station last visited from Outfitting.json.
+func (c *Client) GetOutfitting() (*Outfitting, error) {
+	var outfitting *Outfitting
+	err := c.readCompanionFile("Outfitting.json", func(content []byte) (err error) {
+		outfitting, err = GetOutfittingFromBytes(content)
+		return err
+	})
+	return outfitting, err
+}
+
+// GetOutfittingFromBytes reads the modules for sale at the station last visited from the string contained in the byte array.
+func GetOutfittingFromBytes(content []byte) (*Outfitting, error) {
+	outfitting := &Outfitting{}
+	if err := json.Unmarshal(content, outfitting); err != nil {
+		return nil, errors.New("Couldn't unmarshal Outfitting.json file: " + err.Error())
+	}
+
+	return outfitting, nil
+}
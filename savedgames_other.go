@@ -0,0 +1,13 @@
+//go:build !windows
+// +build !windows
+
+package elite
+
+import (
+	"errors"
+)
+
+// savedGamesDir returns the user's Saved Games folder, which only exists on Windows.
+func savedGamesDir() (string, error) {
+	return "", errors.New("Saved Games folder is only available on Windows")
+}
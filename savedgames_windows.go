@@ -0,0 +1,49 @@
+package elite
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	shell32                  = syscall.NewLazyDLL("shell32.dll")
+	ole32                    = syscall.NewLazyDLL("ole32.dll")
+	procSHGetKnownFolderPath = shell32.NewProc("SHGetKnownFolderPath")
+	procCoTaskMemFree        = ole32.NewProc("CoTaskMemFree")
+
+	// folderIDSavedGames is FOLDERID_SavedGames.
+	folderIDSavedGames = syscall.GUID{
+		Data1: 0x4C5C32FF,
+		Data2: 0xBB9D,
+		Data3: 0x43B0,
+		Data4: [8]byte{0xB5, 0xB4, 0x2D, 0x72, 0xE5, 0x4E, 0xAA, 0xA4},
+	}
+)
+
+// savedGamesDir returns the user's Saved Games folder, which may have been
+// moved from its default location under the user's profile.
+func savedGamesDir() (string, error) {
+	if err := procSHGetKnownFolderPath.Find(); err != nil {
+		return "", err
+	}
+
+	var path *uint16
+	result, _, _ := procSHGetKnownFolderPath.Call(
+		uintptr(unsafe.Pointer(&folderIDSavedGames)), 0, 0, uintptr(unsafe.Pointer(&path)))
+	if path != nil {
+		defer procCoTaskMemFree.Call(uintptr(unsafe.Pointer(path)))
+	}
+	if result != 0 {
+		return "", syscall.Errno(result)
+	}
+
+	var chars []uint16
+	for i := uintptr(0); ; i++ {
+		c := *(*uint16)(unsafe.Pointer(uintptr(unsafe.Pointer(path)) + i*2))
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return syscall.UTF16ToString(chars), nil
+}
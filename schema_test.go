@@ -0,0 +1,106 @@
+package elite_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BenJuan26/elite"
+)
+
+// loadoutSamples cover the parts of the Loadout schema that the test journal doesn't.
+var loadoutSamples = []string{
+	`{ "timestamp":"2022-03-01T20:12:04Z", "event":"Loadout", "Ship":"anaconda", "ShipID":3, "ShipName":"", "ShipIdent":"AN-01", "HullValue":142447820, "ModulesValue":310000000, "HullHealth":0.874512, "UnladenMass":1183.5, "CargoCapacity":32, "MaxJumpRange":25.1, "FuelCapacity":{ "Main":32.000000, "Reserve":1.070000 }, "Rebuy":22600000, "Hot":true, "Modules":[ { "Slot":"LargeHardpoint1", "Item":"hpt_multicannon_gimbal_large", "On":true, "Priority":0, "AmmoInClip":90, "AmmoInHopper":2100, "Health":0.963, "Value":556120, "Engineering":{ "Engineer":"Tod 'The Blaster' McQuinn", "EngineerID":300260, "BlueprintID":128673345, "BlueprintName":"Weapon_Overcharged", "Level":5, "Quality":1.000000, "ExperimentalEffect":"special_corrosive_shell", "ExperimentalEffect_Localised":"Corrosive Shell", "Modifiers":[ { "Label":"DamagePerSecond", "Value":31.3, "OriginalValue":18.2, "LessIsGood":0 }, { "Label":"WeaponMode", "ValueStr":"$WeaponMode_Burst;", "ValueStr_Localised":"Burst" } ] } }, { "Slot":"Armour", "Item":"anaconda_armour_grade3", "On":true, "Priority":1, "Health":1.000000 } ] }`,
+	`{ "timestamp":"2022-03-02T18:40:11Z", "event":"Loadout", "Ship":"vulture", "ShipID":4, "ShipName":"", "ShipIdent":"VU-01", "HullValue":4689629, "ModulesValue":2900000, "HullHealth":1.000000, "UnladenMass":291.4, "CargoCapacity":0, "MaxJumpRange":10.2, "FuelCapacity":{ "Main":8.000000, "Reserve":0.570000 }, "Rebuy":379000, "Modules":[ { "Slot":"TinyHardpoint1", "Item":"hpt_heatsinklauncher_turret_tiny", "On":true, "Priority":0, "AmmoInClip":0, "AmmoInHopper":2, "Health":1.000000 }, { "Slot":"LargeHardpoint1", "Item":"hpt_beamlaser_gimbal_large", "On":true, "Priority":0, "Health":1.000000 } ] }`,
+}
+
+func TestLoadoutSchema(t *testing.T) {
+	samples := append([]string(nil), loadoutSamples...)
+
+	paths, _ := filepath.Glob(filepath.Join(testLogPath, "Journal.*.log"))
+	for _, path := range paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			fmt.Println("Couldn't read journal: " + err.Error())
+			t.FailNow()
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		scanner.Buffer(nil, 1024*1024)
+		for scanner.Scan() {
+			if strings.Contains(scanner.Text(), `"event":"Loadout"`) {
+				samples = append(samples, scanner.Text())
+			}
+		}
+	}
+	if len(samples) <= len(loadoutSamples) {
+		fmt.Println("No Loadout events in the test journal")
+		t.FailNow()
+	}
+
+	for _, sample := range samples {
+		// Decode strictly, so that a field of the wrong type fails the test
+		// rather than being left at its zero value.
+		l := &elite.Loadout{}
+		if err := json.Unmarshal([]byte(sample), l); err != nil {
+			fmt.Println("Couldn't decode Loadout: " + err.Error())
+			t.FailNow()
+		}
+		encoded, err := json.Marshal(l)
+		if err != nil {
+			fmt.Println("Couldn't encode Loadout: " + err.Error())
+			t.FailNow()
+		}
+
+		var want, got interface{}
+		json.Unmarshal([]byte(sample), &want)
+		json.Unmarshal(encoded, &got)
+		if missing := missingFields("", want, got); len(missing) > 0 {
+			fmt.Printf("Loadout fields not captured: %s\n", strings.Join(missing, ", "))
+			t.FailNow()
+		}
+	}
+
+	// An empty clip is written as zero, while lasers have no ammunition at all.
+	l := &elite.Loadout{}
+	json.Unmarshal([]byte(loadoutSamples[1]), l)
+	heatSinks, laser := l.Modules[0], l.Modules[1]
+	if heatSinks.AmmoInClip == nil || *heatSinks.AmmoInClip != 0 || laser.AmmoInClip != nil {
+		fmt.Printf("Incorrect ammunition: %+v, %+v\n", heatSinks, laser)
+		t.FailNow()
+	}
+	if l.FuelCapacity.Reservoir() != 0.57 {
+		fmt.Printf("Incorrect reserve tank: %+v\n", l.FuelCapacity)
+		t.FailNow()
+	}
+}
+
+// missingFields returns the paths of the values in want that aren't the same in got.
+func missingFields(path string, want, got interface{}) []string {
+	var missing []string
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got, _ := got.(map[string]interface{})
+		for key, value := range want {
+			missing = append(missing, missingFields(path+"."+key, value, got[key])...)
+		}
+	case []interface{}:
+		got, _ := got.([]interface{})
+		if len(got) != len(want) {
+			return []string{path}
+		}
+		for i := range want {
+			missing = append(missing, missingFields(fmt.Sprintf("%s[%d]", path, i), want[i], got[i])...)
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			missing = append(missing, path)
+		}
+	}
+	return missing
+}
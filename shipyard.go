@@ -0,0 +1,63 @@
+package elite
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ShipyardItem is a ship for sale in a station's shipyard.
+type ShipyardItem struct {
+	ID                int64  `json:"id"`
+	ShipType          string `json:"ShipType"`
+	ShipTypeLocalised string `json:"ShipType_Localised"`
+	ShipPrice         int64  `json:"ShipPrice"`
+}
+
+// Shipyard describes the ships for sale at a station. It is written when
+// the player opens the shipyard; the journal event only identifies the
+// station, while Shipyard.json also lists the ships in PriceList.
+type Shipyard struct {
+	*JournalEntry
+	MarketID       int64          `json:"MarketID"`
+	StationName    string         `json:"StationName"`
+	StarSystem     string         `json:"StarSystem"`
+	Horizons       bool           `json:"Horizons"`
+	AllowCobraMkIV bool           `json:"AllowCobraMkIV"`
+	PriceList      []ShipyardItem `json:"PriceList,omitempty"`
+}
+
+// GetShipyard reads the ships for sale at the station last visited from Shipyard.json.
+// It will read it from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetShipyardFromPath.
+func GetShipyard() (*Shipyard, error) {
+	return GetShipyardFromPath(defaultLogPath())
+}
+
+// GetShipyardFromPath reads the ships for sale at the station last visited from Shipyard.json at the specified log path.
+func GetShipyardFromPath(logPath string) (*Shipyard, error) {
+	return NewClient(WithLogPath(logPath)).GetShipyard()
+}
+
+// GetShipyard reads the ships for sale at the station last visited from Shipyard.json.
+func (c *Client) GetShipyard() (*Shipyard, error) {
+	var shipyard *Shipyard
+	err := c.readCompanionFile("Shipyard.json", func(content []byte) (err error) {
+		shipyard, err = GetShipyardFromBytes(content)
+		return err
+	})
+	return shipyard, err
+}
+
+// GetShipyardFromBytes reads the ships for sale at the station last visited from the string contained in the byte array.
+func GetShipyardFromBytes(content []byte) (*Shipyard, error) {
+	shipyard := &Shipyard{}
+	if err := json.Unmarshal(content, shipyard); err != nil {
+		return nil, errors.New("Couldn't unmarshal Shipyard.json file: " + err.Error())
+	}
+
+	return shipyard, nil
+}
@@ -1,12 +1,8 @@
 package elite
 
 import (
-	"bufio"
-	"encoding/json"
 	"errors"
-	"io/ioutil"
-	"os"
-	"path/filepath"
+	"fmt"
 )
 
 // StarSystemEvent is an event that contains the current star system.
@@ -18,40 +14,27 @@ type StarSystemEvent struct {
 
 // GetStarSystem returns the current star system.
 func GetStarSystem() (string, error) {
-	return GetStarSystemFromPath(defaultLogPath)
+	return GetStarSystemFromPath(defaultLogPath())
 }
 
 // GetStarSystemFromPath returns the current star system using the specified log path.
 func GetStarSystemFromPath(logPath string) (string, error) {
-	files, _ := ioutil.ReadDir(logPath)
-
-	found := false
-	var event StarSystemEvent
-	for i := len(files) - 1; i >= 0 && !found; i-- {
-		if !journalFilePattern.MatchString(files[i].Name()) {
-			continue
-		}
-
-		journalFile, err := os.Open(filepath.Join(logPath, files[i].Name()))
-		if err != nil {
-			return "", err
-		}
-		defer journalFile.Close()
+	return NewClient(WithLogPath(logPath)).GetStarSystem()
+}
 
-		scanner := bufio.NewScanner(journalFile)
-		for scanner.Scan() {
-			var tempEvent StarSystemEvent
-			json.Unmarshal([]byte(scanner.Text()), &tempEvent)
-			if tempEvent.Event == "FSDJump" || tempEvent.Event == "Location" {
-				event = tempEvent
-				found = true
-			}
-		}
+// GetStarSystem returns the current star system.
+func (c *Client) GetStarSystem() (string, error) {
+	line, err := c.findLastLine("FSDJump", "Location")
+	if errors.Is(err, ErrNotFound) {
+		return "", fmt.Errorf("Couldn't get location: %w", err)
 	}
-
-	if !found {
-		return "", errors.New("No location found in all log files")
+	if err != nil {
+		return "", err
 	}
 
+	event := StarSystemEvent{}
+	if err := decodeEvent(line, &event); err != nil {
+		return "", err
+	}
 	return event.StarSystem, nil
 }
@@ -1,12 +1,8 @@
 package elite
 
 import (
-	"bufio"
-	"encoding/json"
 	"errors"
-	"io/ioutil"
-	"os"
-	"path/filepath"
+	"fmt"
 
 	"github.com/BenJuan26/elite/stats"
 )
@@ -25,43 +21,34 @@ type Statistics struct {
 	Passengers      stats.Passengers      `json:"Passengers"`
 	SearchAndRescue stats.SearchAndRescue `json:"Search_And_Rescue"`
 	Crafting        stats.Crafting        `json:"Crafting"`
-	// Crew            stats.Crew            `json:"Crew"`
-	Multicrew      stats.Multicrew      `json:"Multicrew"`
-	MaterialTrader stats.MaterialTrader `json:"Material_Trader_Stats"`
+	Crew            stats.Crew            `json:"Crew"`
+	Multicrew       stats.Multicrew       `json:"Multicrew"`
+	MaterialTrader  stats.MaterialTrader  `json:"Material_Trader_Stats"`
+	CQC             stats.CQC             `json:"CQC"`
+	TGEncounters    stats.TGEncounters    `json:"TG_ENCOUNTERS"`
+	FleetCarrier    stats.FleetCarrier    `json:"FLEETCARRIER"`
+	Exobiology      stats.Exobiology      `json:"Exobiology"`
 }
 
 // GetStatisticsFromPath returns game statistics using the specified log path.
 func GetStatisticsFromPath(logPath string) (*Statistics, error) {
-	files, _ := ioutil.ReadDir(logPath)
-
-	found := false
-	var stats *Statistics
-	for i := len(files) - 1; i >= 0 && !found; i-- {
-		if !journalFilePattern.MatchString(files[i].Name()) {
-			continue
-		}
-
-		journalFile, err := os.Open(filepath.Join(logPath, files[i].Name()))
-		if err != nil {
-			return stats, err
-		}
-		defer journalFile.Close()
+	return NewClient(WithLogPath(logPath)).GetStatistics()
+}
 
-		scanner := bufio.NewScanner(journalFile)
-		for scanner.Scan() {
-			var tempEvent Statistics
-			json.Unmarshal([]byte(scanner.Text()), &tempEvent)
-			if tempEvent.Event == "Statistics" {
-				stats = &tempEvent
-				found = true
-			}
-		}
+// GetStatistics returns game statistics.
+func (c *Client) GetStatistics() (*Statistics, error) {
+	line, err := c.findLastLine("Statistics")
+	if errors.Is(err, ErrNotFound) {
+		return nil, fmt.Errorf("Couldn't get statistics: %w", err)
 	}
-
-	if !found {
-		return stats, errors.New("No location found in all log files")
+	if err != nil {
+		return nil, err
 	}
 
+	stats := &Statistics{}
+	if err := decodeEvent(line, stats); err != nil {
+		return nil, err
+	}
 	return stats, nil
 }
 
@@ -73,5 +60,5 @@ func GetStatisticsFromPath(logPath string) (*Statistics, error) {
 //
 // If that path is not suitable, use GetStatisticsFromPath.
 func GetStatistics() (*Statistics, error) {
-	return GetStatisticsFromPath(defaultLogPath)
+	return GetStatisticsFromPath(defaultLogPath())
 }
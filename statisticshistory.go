@@ -0,0 +1,192 @@
+package elite
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// StatisticsHistory holds every Statistics event written for a commander,
+// oldest first. The game writes one each time the commander logs in.
+type StatisticsHistory []*Statistics
+
+// StatisticsPoint is the value of a single statistic at one point in time.
+type StatisticsPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// StatisticsChange is the change in a single statistic between two snapshots.
+// Category and Field are the names of the fields in Statistics and its
+// category, such as "BankAccount" and "CurrentWealth".
+type StatisticsChange struct {
+	Category string
+	Field    string
+	From     float64
+	To       float64
+}
+
+// Delta returns how much the statistic changed.
+func (change StatisticsChange) Delta() float64 {
+	return change.To - change.From
+}
+
+// StatisticsDiff lists the statistics that changed between two snapshots,
+// in the order they appear in Statistics.
+type StatisticsDiff []StatisticsChange
+
+// Delta returns how much the named statistic changed, or zero if it didn't.
+func (diff StatisticsDiff) Delta(category, field string) float64 {
+	for _, change := range diff {
+		if change.Category == category && change.Field == field {
+			return change.Delta()
+		}
+	}
+	return 0
+}
+
+// DiffStatistics compares every numeric statistic in two snapshots, and
+// returns those that differ. A nil snapshot counts as every statistic being zero.
+// Statistics written as text, such as the system of the last Thargoid
+// encounter, aren't compared.
+func DiffStatistics(from, to *Statistics) StatisticsDiff {
+	if from == nil {
+		from = &Statistics{}
+	}
+	if to == nil {
+		to = &Statistics{}
+	}
+
+	var diff StatisticsDiff
+	eachStatistic(from, func(category, field string, value float64) {
+		diff = append(diff, StatisticsChange{Category: category, Field: field, From: value})
+	})
+	i := 0
+	eachStatistic(to, func(category, field string, value float64) {
+		diff[i].To = value
+		i++
+	})
+
+	changed := diff[:0]
+	for _, change := range diff {
+		if change.From != change.To {
+			changed = append(changed, change)
+		}
+	}
+	return changed
+}
+
+// eachStatistic calls fn with the value of every numeric statistic in stats.
+func eachStatistic(stats *Statistics, fn func(category, field string, value float64)) {
+	v := reflect.ValueOf(stats).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		category := v.Type().Field(i)
+		if category.Anonymous || category.Type.Kind() != reflect.Struct {
+			continue
+		}
+		fields := v.Field(i)
+		for j := 0; j < fields.NumField(); j++ {
+			field := fields.Field(j)
+			switch field.Kind() {
+			case reflect.Int, reflect.Int32, reflect.Int64:
+				fn(category.Name, fields.Type().Field(j).Name, float64(field.Int()))
+			case reflect.Float32, reflect.Float64:
+				fn(category.Name, fields.Type().Field(j).Name, field.Float())
+			}
+		}
+	}
+}
+
+// At returns the latest snapshot written at or before t, or nil if there is none.
+func (history StatisticsHistory) At(t time.Time) *Statistics {
+	i := sort.Search(len(history), func(i int) bool {
+		return history[i].EventTime().After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return history[i-1]
+}
+
+// Latest returns the most recent snapshot, or nil if there is none.
+func (history StatisticsHistory) Latest() *Statistics {
+	if len(history) == 0 {
+		return nil
+	}
+	return history[len(history)-1]
+}
+
+// Since returns how the statistics have changed from t to the most recent
+// snapshot, and the time of the snapshot the changes are counted from. If
+// there is no snapshot from before t, the changes are counted from the
+// first snapshot after it, so they cover less time than was asked for.
+// It returns a zero time if there are no snapshots.
+func (history StatisticsHistory) Since(t time.Time) (StatisticsDiff, time.Time) {
+	if len(history) == 0 {
+		return nil, time.Time{}
+	}
+	from := history.At(t)
+	if from == nil {
+		from = history[0]
+	}
+	return DiffStatistics(from, history.Latest()), from.EventTime()
+}
+
+// Series returns the value of a single statistic in every snapshot, such as
+// Series("BankAccount", "CurrentWealth"). It returns nil if there is no
+// such statistic.
+func (history StatisticsHistory) Series(category, field string) []StatisticsPoint {
+	var series []StatisticsPoint
+	for _, stats := range history {
+		eachStatistic(stats, func(c, f string, value float64) {
+			if c == category && f == field {
+				series = append(series, StatisticsPoint{Time: stats.EventTime(), Value: value})
+			}
+		})
+	}
+	return series
+}
+
+// GetStatisticsHistory reads every Statistics event of the most recent commander from the journal files.
+// It will read them from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetStatisticsHistoryFromPath.
+func GetStatisticsHistory() (StatisticsHistory, error) {
+	return GetStatisticsHistoryFromPath(defaultLogPath())
+}
+
+// GetStatisticsHistoryFromPath reads every Statistics event of the most recent commander from the journal files at the specified path.
+func GetStatisticsHistoryFromPath(logPath string) (StatisticsHistory, error) {
+	return NewClient(WithLogPath(logPath)).GetStatisticsHistory()
+}
+
+// GetStatisticsHistory reads every Statistics event of the most recent commander from the journal files.
+func (c *Client) GetStatisticsHistory() (StatisticsHistory, error) {
+	commander, err := c.lastCommander()
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := c.OpenJournal(JournalFilter{Events: []string{"Statistics"}, Commander: commander})
+	if err != nil {
+		return nil, err
+	}
+	defer journal.Close()
+
+	var history StatisticsHistory
+	for journal.Next() {
+		if stats, ok := journal.Event().(*Statistics); ok {
+			history = append(history, stats)
+		}
+	}
+	if err := journal.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].EventTime().Before(history[j].EventTime())
+	})
+	return history, nil
+}
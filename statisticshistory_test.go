@@ -0,0 +1,111 @@
+package elite_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+var statisticsJournals = map[string][]string{
+	"Journal.210501100000.01.log": {
+		`{ "timestamp":"2021-05-01T10:00:00Z", "event":"Commander", "FID":"F1", "Name":"Jameson" }`,
+		`{ "timestamp":"2021-05-01T10:00:01Z", "event":"Statistics", "Bank_Account":{ "Current_Wealth":1000000, "Spent_On_Fuel":500 }, "Exploration":{ "Systems_Visited":10, "Greatest_Distance_From_Start":100.5 } }`,
+	},
+	"Journal.210505100000.01.log": {
+		`{ "timestamp":"2021-05-05T10:00:00Z", "event":"Commander", "FID":"F2", "Name":"Other" }`,
+		`{ "timestamp":"2021-05-05T10:00:01Z", "event":"Statistics", "Bank_Account":{ "Current_Wealth":1 } }`,
+	},
+	"Journal.210508100000.01.log": {
+		`{ "timestamp":"2021-05-08T10:00:00Z", "event":"Commander", "FID":"F1", "Name":"Jameson" }`,
+		`{ "timestamp":"2021-05-08T10:00:01Z", "event":"Statistics", "Bank_Account":{ "Current_Wealth":1500000, "Spent_On_Fuel":500 }, "Exploration":{ "Systems_Visited":25, "Greatest_Distance_From_Start":100.5 } }`,
+	},
+	"Journal.210515100000.01.log": {
+		`{ "timestamp":"2021-05-15T10:00:00Z", "event":"Commander", "FID":"F1", "Name":"Jameson" }`,
+		`{ "timestamp":"2021-05-15T10:00:01Z", "event":"Statistics", "Bank_Account":{ "Current_Wealth":2500000, "Spent_On_Fuel":800 }, "Exploration":{ "Systems_Visited":40, "Greatest_Distance_From_Start":100.5 } }`,
+	},
+}
+
+func TestStatisticsHistory(t *testing.T) {
+	client := elite.NewClient(elite.WithFS(journalFS(statisticsJournals)))
+	history, err := client.GetStatisticsHistory()
+	if err != nil {
+		fmt.Println("Couldn't get statistics history: " + err.Error())
+		t.FailNow()
+	}
+	if len(history) != 3 {
+		fmt.Printf("Expected 3 snapshots for Jameson, got %d\n", len(history))
+		t.FailNow()
+	}
+
+	series := history.Series("BankAccount", "CurrentWealth")
+	if len(series) != 3 || series[0].Value != 1000000 || series[2].Value != 2500000 ||
+		!series[2].Time.Equal(time.Date(2021, 5, 15, 10, 0, 1, 0, time.UTC)) {
+		fmt.Printf("Incorrect wealth series: %+v\n", series)
+		t.FailNow()
+	}
+	if history.Series("BankAccount", "NotAStatistic") != nil {
+		fmt.Println("Expected no series for an unknown statistic")
+		t.FailNow()
+	}
+
+	week, from := history.Since(time.Date(2021, 5, 9, 0, 0, 0, 0, time.UTC))
+	if !from.Equal(time.Date(2021, 5, 8, 10, 0, 1, 0, time.UTC)) || week.Delta("BankAccount", "CurrentWealth") != 1000000 || week.Delta("Exploration", "SystemsVisited") != 15 {
+		fmt.Printf("Incorrect weekly diff: %+v\n", week)
+		t.FailNow()
+	}
+
+	diff := elite.DiffStatistics(history[0], history[1])
+	if len(diff) != 2 || diff[0].Field != "CurrentWealth" || diff[1].Field != "SystemsVisited" {
+		fmt.Printf("Only changed statistics should be listed: %+v\n", diff)
+		t.FailNow()
+	}
+
+	_, from = history.Since(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC))
+	if !from.Equal(time.Date(2021, 5, 1, 10, 0, 1, 0, time.UTC)) {
+		fmt.Println("Since should count from the first snapshot when there is none before it")
+		t.FailNow()
+	}
+	if len(elite.DiffStatistics(nil, history[0])) != 4 || len(elite.DiffStatistics(nil, nil)) != 0 {
+		fmt.Println("A nil snapshot should count as all zeroes")
+		t.FailNow()
+	}
+
+	var snapshots []*elite.Statistics
+	for _, line := range []string{
+		`{ "timestamp":"2021-05-01T10:00:01Z", "event":"Statistics", "Crew":{ "NpcCrew_TotalWages":1000, "NpcCrew_Hired":1 }, "CQC":{ "CQC_Kills":2, "CQC_KD":0.5 }, "TG_ENCOUNTERS":{ "TG_ENCOUNTER_TOTAL":3, "TG_ENCOUNTER_TOTAL_LAST_SYSTEM":"Maia" }, "FLEETCARRIER":{ "FLEETCARRIER_TOTAL_JUMPS":10, "FLEETCARRIER_DISTANCE_TRAVELLED":"150 LY" }, "Exobiology":{ "Organic_Data_Profits":5000000 } }`,
+		`{ "timestamp":"2021-05-08T10:00:01Z", "event":"Statistics", "Crew":{ "NpcCrew_TotalWages":3000, "NpcCrew_Hired":1 }, "CQC":{ "CQC_Kills":4, "CQC_KD":1.0 }, "TG_ENCOUNTERS":{ "TG_ENCOUNTER_TOTAL":5, "TG_ENCOUNTER_TOTAL_LAST_SYSTEM":"Pleione" }, "FLEETCARRIER":{ "FLEETCARRIER_TOTAL_JUMPS":12, "FLEETCARRIER_DISTANCE_TRAVELLED":"450 LY" }, "Exobiology":{ "Organic_Data_Profits":9000000 } }`,
+	} {
+		event, err := elite.ParseEvent([]byte(line))
+		if err != nil {
+			fmt.Println("Couldn't parse statistics: " + err.Error())
+			t.FailNow()
+		}
+		snapshots = append(snapshots, event.(*elite.Statistics))
+	}
+	diff = elite.DiffStatistics(snapshots[0], snapshots[1])
+	if len(diff) != 6 || diff.Delta("Crew", "NpcCrewTotalWages") != 2000 || diff.Delta("CQC", "CQCKD") != 0.5 ||
+		diff.Delta("TGEncounters", "TGEncounterTotal") != 2 || diff.Delta("FleetCarrier", "FleetCarrierTotalJumps") != 2 ||
+		diff.Delta("Exobiology", "OrganicDataProfits") != 4000000 {
+		fmt.Printf("Incorrect diff of the newer categories: %+v\n", diff)
+		t.FailNow()
+	}
+
+	if history.At(time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC)) != nil || history.At(time.Date(2021, 5, 9, 0, 0, 0, 0, time.UTC)) != history[1] {
+		fmt.Println("Incorrect snapshot returned by At")
+		t.FailNow()
+	}
+}
+
+func TestGetStatisticsHistoryFromPath(t *testing.T) {
+	history, err := elite.GetStatisticsHistoryFromPath(testLogPath)
+	if err != nil {
+		fmt.Println("Couldn't get statistics history: " + err.Error())
+		t.FailNow()
+	}
+	if len(history) != 1 || history.Latest().BankAccount.CurrentWealth != 951994467 {
+		fmt.Println("Incorrect statistics history")
+		t.FailNow()
+	}
+}
@@ -101,8 +101,13 @@ type Crafting struct {
 	RecipesGeneratedRank5 int64 `json:"Recipes_Generated_Rank_5"`
 }
 
-// type Crew struct {
-// }
+// Crew contains statistics about hired NPC crew.
+type Crew struct {
+	NpcCrewTotalWages int64 `json:"NpcCrew_TotalWages"`
+	NpcCrewHired      int64 `json:"NpcCrew_Hired"`
+	NpcCrewFired      int64 `json:"NpcCrew_Fired"`
+	NpcCrewDied       int64 `json:"NpcCrew_Died"`
+}
 
 // Multicrew contains statistics about multicrew.
 type Multicrew struct {
@@ -124,3 +129,62 @@ type MaterialTrader struct {
 	Grade4MaterialsTraded  int64 `json:"Grade_4_Materials_Traded"`
 	Grade5MaterialsTraded  int64 `json:"Grade_5_Materials_Traded"`
 }
+
+// CQC contains statistics about CQC Championship matches.
+type CQC struct {
+	CQCCreditsEarned int64   `json:"CQC_Credits_Earned"`
+	CQCTimePlayed    int64   `json:"CQC_Time_Played"`
+	CQCKD            float64 `json:"CQC_KD"`
+	CQCKills         int64   `json:"CQC_Kills"`
+	CQCWL            float64 `json:"CQC_WL"`
+}
+
+// TGEncounters contains statistics about encounters with the Thargoids.
+// The details of the last encounter are written as text.
+type TGEncounters struct {
+	TGEncounterKilled             int64  `json:"TG_ENCOUNTER_KILLED"`
+	TGEncounterTotal              int64  `json:"TG_ENCOUNTER_TOTAL"`
+	TGEncounterTotalLastSystem    string `json:"TG_ENCOUNTER_TOTAL_LAST_SYSTEM"`
+	TGEncounterTotalLastTimestamp string `json:"TG_ENCOUNTER_TOTAL_LAST_TIMESTAMP"`
+	TGEncounterTotalLastShip      string `json:"TG_ENCOUNTER_TOTAL_LAST_SHIP"`
+	TGScoutCount                  int64  `json:"TG_SCOUT_COUNT"`
+	TGEncounterWakes              int64  `json:"TG_ENCOUNTER_WAKES"`
+}
+
+// FleetCarrier contains statistics about the commander's fleet carrier.
+// The distance travelled is written as text, such as "1234.5 LY".
+type FleetCarrier struct {
+	FleetCarrierExportTotal       int64  `json:"FLEETCARRIER_EXPORT_TOTAL"`
+	FleetCarrierImportTotal       int64  `json:"FLEETCARRIER_IMPORT_TOTAL"`
+	FleetCarrierTradeProfitTotal  int64  `json:"FLEETCARRIER_TRADEPROFIT_TOTAL"`
+	FleetCarrierTradeSpendTotal   int64  `json:"FLEETCARRIER_TRADESPEND_TOTAL"`
+	FleetCarrierStolenProfitTotal int64  `json:"FLEETCARRIER_STOLENPROFIT_TOTAL"`
+	FleetCarrierStolenSpendTotal  int64  `json:"FLEETCARRIER_STOLENSPEND_TOTAL"`
+	FleetCarrierDistanceTravelled string `json:"FLEETCARRIER_DISTANCE_TRAVELLED"`
+	FleetCarrierTotalJumps        int64  `json:"FLEETCARRIER_TOTAL_JUMPS"`
+	FleetCarrierShipyardSold      int64  `json:"FLEETCARRIER_SHIPYARD_SOLD"`
+	FleetCarrierShipyardProfit    int64  `json:"FLEETCARRIER_SHIPYARD_PROFIT"`
+	FleetCarrierOutfittingSold    int64  `json:"FLEETCARRIER_OUTFITTING_SOLD"`
+	FleetCarrierOutfittingProfit  int64  `json:"FLEETCARRIER_OUTFITTING_PROFIT"`
+	FleetCarrierRearmTotal        int64  `json:"FLEETCARRIER_REARM_TOTAL"`
+	FleetCarrierRefuelTotal       int64  `json:"FLEETCARRIER_REFUEL_TOTAL"`
+	FleetCarrierRefuelProfit      int64  `json:"FLEETCARRIER_REFUEL_PROFIT"`
+	FleetCarrierRepairsTotal      int64  `json:"FLEETCARRIER_REPAIRS_TOTAL"`
+	FleetCarrierVouchersRedeemed  int64  `json:"FLEETCARRIER_VOUCHERS_REDEEMED"`
+	FleetCarrierVouchersProfit    int64  `json:"FLEETCARRIER_VOUCHERS_PROFIT"`
+}
+
+// Exobiology contains statistics about scanning and selling organic data.
+type Exobiology struct {
+	OrganicGenusEncountered   int64 `json:"Organic_Genus_Encountered"`
+	OrganicSpeciesEncountered int64 `json:"Organic_Species_Encountered"`
+	OrganicVariantEncountered int64 `json:"Organic_Variant_Encountered"`
+	OrganicDataProfits        int64 `json:"Organic_Data_Profits"`
+	OrganicData               int64 `json:"Organic_Data"`
+	FirstLoggedProfits        int64 `json:"First_Logged_Profits"`
+	FirstLogged               int64 `json:"First_Logged"`
+	OrganicSystems            int64 `json:"Organic_Systems"`
+	OrganicPlanets            int64 `json:"Organic_Planets"`
+	OrganicGenus              int64 `json:"Organic_Genus"`
+	OrganicSpecies            int64 `json:"Organic_Species"`
+}
@@ -3,10 +3,8 @@ package elite
 import (
 	"encoding/json"
 	"errors"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"time"
+
+	"github.com/BenJuan26/elite/flags"
 )
 
 // Fuel contains fuel readouts for the ship.
@@ -15,58 +13,87 @@ type Fuel struct {
 	Reservoir float64 `json:"FuelReservoir"`
 }
 
+// Pips is the power distributor setting for systems, engines and weapons,
+// in that order. The values are in half-pips, so each ranges from 0 to 8.
+type Pips [3]int32
+
+// Sys returns the number of pips assigned to systems.
+func (pips Pips) Sys() float64 {
+	return float64(pips[0]) / 2
+}
+
+// Eng returns the number of pips assigned to engines.
+func (pips Pips) Eng() float64 {
+	return float64(pips[1]) / 2
+}
+
+// Wep returns the number of pips assigned to weapons.
+func (pips Pips) Wep() float64 {
+	return float64(pips[2]) / 2
+}
+
+// Destination is the target selected in the galaxy or system map.
+type Destination struct {
+	System        int64  `json:"System"`
+	Body          int64  `json:"Body"`
+	Name          string `json:"Name"`
+	NameLocalised string `json:"Name_Localised,omitempty"`
+}
+
 // Status represents the current state of the player and ship.
 type Status struct {
-	Timestamp string      `json:"timestamp"`
-	Event     string      `json:"event"`
-	Flags     StatusFlags `json:"-"`
-	RawFlags  uint32      `json:"Flags"`
-	Pips      [3]int32    `json:"Pips"`
-	FireGroup int32       `json:"FireGroup"`
-	GuiFocus  int32       `json:"GuiFocus"`
-	Fuel      Fuel        `json:"Fuel"`
-	Cargo     float64     `json:"Cargo"`
-	Latitude  float64     `json:"Latitude,omitempty"`
-	Longitude float64     `json:"Longitude,omitempty"`
-	Heading   int32       `json:"Heading,omitempty"`
-	Altitude  int32       `json:"Altitude,omitempty"`
+	Timestamp               string         `json:"timestamp"`
+	Event                   string         `json:"event"`
+	Flags                   StatusFlags    `json:"-"`
+	RawFlags                uint32         `json:"Flags"`
+	Flags2                  StatusFlags2   `json:"-"`
+	RawFlags2               uint32         `json:"Flags2,omitempty"`
+	Pips                    Pips           `json:"Pips"`
+	FireGroup               int32          `json:"FireGroup"`
+	GuiFocus                flags.GuiFocus `json:"GuiFocus"`
+	Fuel                    Fuel           `json:"Fuel"`
+	Cargo                   float64        `json:"Cargo"`
+	LegalState              string         `json:"LegalState,omitempty"`
+	Balance                 int64          `json:"Balance,omitempty"`
+	Latitude                float64        `json:"Latitude,omitempty"`
+	Longitude               float64        `json:"Longitude,omitempty"`
+	Heading                 int32          `json:"Heading,omitempty"`
+	Altitude                int32          `json:"Altitude,omitempty"`
+	BodyName                string         `json:"BodyName,omitempty"`
+	PlanetRadius            float64        `json:"PlanetRadius,omitempty"`
+	Destination             *Destination   `json:"Destination,omitempty"`
+	Oxygen                  float64        `json:"Oxygen,omitempty"`
+	Health                  float64        `json:"Health,omitempty"`
+	Temperature             float64        `json:"Temperature,omitempty"`
+	SelectedWeapon          string         `json:"SelectedWeapon,omitempty"`
+	SelectedWeaponLocalised string         `json:"SelectedWeapon_Localised,omitempty"`
+	Gravity                 float64        `json:"Gravity,omitempty"`
 }
 
 // GetStatus reads the current player and ship status from Status.json.
 // It will read them from the default log path, which is the Saved Games
 // folder. The full path is:
 //
-//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//	C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
 //
 // If that path is not suitable, use GetStatusFromPath.
 func GetStatus() (*Status, error) {
-	return GetStatusFromPath(defaultLogPath)
+	return GetStatusFromPath(defaultLogPath())
 }
 
 // GetStatusFromPath reads the current player and ship status from Status.json at the specified log path.
 func GetStatusFromPath(logPath string) (*Status, error) {
-	statusFilePath := filepath.FromSlash(logPath + "/Status.json")
-	retries := 5
-	for retries > 0 {
-		statusFile, err := os.Open(statusFilePath)
-		if err != nil {
-			retries = retries - 1
-			time.Sleep(3 * time.Millisecond)
-			continue
-		}
-		defer statusFile.Close()
-
-		statusBytes, err := ioutil.ReadAll(statusFile)
-		if err != nil {
-			retries = retries - 1
-			time.Sleep(3 * time.Millisecond)
-			continue
-		}
-
-		return GetStatusFromBytes(statusBytes)
-	}
+	return NewClient(WithLogPath(logPath)).GetStatus()
+}
 
-	return nil, errors.New("Couldn't get status after 5 attempts")
+// GetStatus reads the current player and ship status from Status.json.
+func (c *Client) GetStatus() (*Status, error) {
+	var status *Status
+	err := c.readCompanionFile("Status.json", func(content []byte) (err error) {
+		status, err = GetStatusFromBytes(content)
+		return err
+	})
+	return status, err
 }
 
 // GetStatusFromBytes reads the current player and ship status from the string contained in the byte array.
@@ -0,0 +1,166 @@
+package elite
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// StatusChange describes the differences between two consecutive snapshots of Status.json.
+type StatusChange struct {
+	// Previous is the status before the change. It is nil for the first
+	// snapshot read by a StatusWatcher, in which case the changes are
+	// relative to a zero Status.
+	Previous *Status
+	// Current is the status after the change.
+	Current *Status
+
+	// Flags lists the names of the StatusFlags fields that changed, such as "Docked".
+	Flags []string
+	// Flags2 lists the names of the StatusFlags2 fields that changed, such as "OnFoot".
+	Flags2    []string
+	Pips      bool
+	FireGroup bool
+	GuiFocus  bool
+	Fuel      bool
+	Cargo     bool
+}
+
+// FlagChanged reports whether the named StatusFlags or StatusFlags2 field changed.
+func (change *StatusChange) FlagChanged(name string) bool {
+	for _, flag := range change.Flags {
+		if flag == name {
+			return true
+		}
+	}
+	for _, flag := range change.Flags2 {
+		if flag == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmpty reports whether none of the tracked values changed.
+func (change *StatusChange) IsEmpty() bool {
+	return len(change.Flags) == 0 && len(change.Flags2) == 0 && !change.Pips && !change.FireGroup &&
+		!change.GuiFocus && !change.Fuel && !change.Cargo
+}
+
+// DiffStatus compares two statuses and reports which of the tracked values changed.
+// A nil previous status is treated as a zero Status.
+func DiffStatus(previous, current *Status) StatusChange {
+	change := StatusChange{Previous: previous, Current: current}
+	if previous == nil {
+		previous = &Status{}
+	}
+
+	change.Flags = diffBoolFields(previous.Flags, current.Flags)
+	change.Flags2 = diffBoolFields(previous.Flags2, current.Flags2)
+	change.Pips = previous.Pips != current.Pips
+	change.FireGroup = previous.FireGroup != current.FireGroup
+	change.GuiFocus = previous.GuiFocus != current.GuiFocus
+	change.Fuel = previous.Fuel != current.Fuel
+	change.Cargo = previous.Cargo != current.Cargo
+	return change
+}
+
+// diffBoolFields returns the names of the bool fields that differ between
+// two values of the same struct type.
+func diffBoolFields(a, b interface{}) []string {
+	va := reflect.ValueOf(a)
+	vb := reflect.ValueOf(b)
+
+	var names []string
+	for i := 0; i < va.NumField(); i++ {
+		if va.Field(i).Kind() != reflect.Bool {
+			continue
+		}
+		if va.Field(i).Bool() != vb.Field(i).Bool() {
+			names = append(names, va.Type().Field(i).Name)
+		}
+	}
+	return names
+}
+
+// StatusWatcher polls Status.json and reports what changed each time the game rewrites it.
+// Rewrites that can't be parsed, because the game hasn't finished writing
+// them, are retried on the next poll rather than reported.
+type StatusWatcher struct {
+	// PollInterval is how often Status.json is checked for changes.
+	PollInterval time.Duration
+
+	watch   fileWatch
+	current *Status
+	err     error
+}
+
+// NewStatusWatcher creates a StatusWatcher for the Status.json file at the specified log path.
+func NewStatusWatcher(logPath string) *StatusWatcher {
+	return NewClient(WithLogPath(logPath)).NewStatusWatcher()
+}
+
+// NewStatusWatcher creates a StatusWatcher for the Client's Status.json file.
+func (c *Client) NewStatusWatcher() *StatusWatcher {
+	return &StatusWatcher{
+		PollInterval: DefaultPollInterval,
+		watch:        fileWatch{fsys: c.fsys, name: "Status.json"},
+	}
+}
+
+// Changes starts watching Status.json and returns a channel of changes.
+// Rewrites that don't change any of the values tracked by StatusChange are not sent.
+// The channel is closed when the context is cancelled or an error occurs,
+// after which Err reports the error, if any.
+// Changes should only be called once per StatusWatcher.
+func (w *StatusWatcher) Changes(ctx context.Context) <-chan StatusChange {
+	changes := make(chan StatusChange)
+	go w.run(ctx, changes)
+	return changes
+}
+
+// Err returns the error that stopped the StatusWatcher, if any.
+// It is only valid once the channel returned by Changes has been closed.
+func (w *StatusWatcher) Err() error {
+	return w.err
+}
+
+func (w *StatusWatcher) run(ctx context.Context, changes chan<- StatusChange) {
+	defer close(changes)
+
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		content, err := w.watch.poll()
+		if err != nil {
+			w.err = err
+			return
+		}
+
+		if content != nil {
+			if status, err := GetStatusFromBytes(content); err == nil {
+				w.watch.accept()
+				change := DiffStatus(w.current, status)
+				w.current = status
+				if !change.IsEmpty() || change.Previous == nil {
+					select {
+					case changes <- change:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,340 @@
+package elite
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BenJuan26/elite/loadout"
+)
+
+// StoredModule is a module in the commander's module storage.
+type StoredModule struct {
+	// StorageSlot identifies the module in storage. It is zero for modules
+	// stored since outfitting was last opened, until it is opened again.
+	StorageSlot   int64
+	Name          string
+	NameLocalised string
+
+	// StarSystem, StationName and MarketID give where the module is stored,
+	// or where it is being sent while InTransit. StationName is only known
+	// for modules stored while the commander was docked there.
+	StarSystem  string
+	StationName string
+	MarketID    int64
+	InTransit   bool
+	// ArrivesAt is when a module being sent to another station arrives.
+	// It is only known for transfers started during the journal history.
+	ArrivesAt time.Time
+
+	// TransferCost and TransferTime are the cost and time in seconds to
+	// send the module to the station where outfitting was last opened.
+	TransferCost int64
+	TransferTime int64
+	BuyPrice     int64
+	Hot          bool
+
+	// Engineering is nil for modules that aren't engineered. The engineer
+	// and modifiers are only known for modules stored during the journal
+	// history from a ship with a Loadout; otherwise only the blueprint,
+	// level and quality are set.
+	Engineering *loadout.Engineering
+}
+
+// Remaining returns how long is left until a module in transit arrives,
+// or zero if it isn't in transit or its arrival time isn't known.
+func (module *StoredModule) Remaining(now time.Time) time.Duration {
+	if !module.InTransit || module.ArrivesAt.IsZero() || !now.Before(module.ArrivesAt) {
+		return 0
+	}
+	return module.ArrivesAt.Sub(now)
+}
+
+// StoredModules tracks the commander's module storage, built up by applying
+// journal events in order.
+//
+// A StoredModules is not safe for concurrent use.
+type StoredModules struct {
+	Items []*StoredModule
+
+	starSystem  string
+	stationName string
+	marketID    int64
+	// fitted holds the modules fitted to each ship, by ShipID and slot, so
+	// that stored modules keep their full engineering.
+	fitted map[int64]map[string]loadout.Module
+}
+
+// NewStoredModules creates an empty StoredModules, ready to have events applied to it.
+func NewStoredModules() *StoredModules {
+	return &StoredModules{fitted: map[int64]map[string]loadout.Module{}}
+}
+
+// ByStation returns the stored modules grouped by the MarketID of the
+// station they are stored at, each group ordered by name.
+func (storage *StoredModules) ByStation() map[int64][]*StoredModule {
+	stations := map[int64][]*StoredModule{}
+	for _, module := range storage.Items {
+		stations[module.MarketID] = append(stations[module.MarketID], module)
+	}
+	for _, modules := range stations {
+		sort.SliceStable(modules, func(i, j int) bool {
+			return moduleSymbol(modules[i].Name) < moduleSymbol(modules[j].Name)
+		})
+	}
+	return stations
+}
+
+// Apply updates the storage with a single journal event.
+// Events that don't affect the storage are ignored.
+func (storage *StoredModules) Apply(event Event) {
+	switch e := event.(type) {
+	case *Location:
+		if e.Docked {
+			storage.setLocation(e.StarSystem, e.StationName, e.MarketID)
+		} else {
+			storage.setLocation(e.StarSystem, "", 0)
+		}
+	case *FSDJump:
+		storage.setLocation(e.StarSystem, "", 0)
+	case *Docked:
+		storage.setLocation(e.StarSystem, e.StationName, e.MarketID)
+	case *Undocked:
+		storage.setLocation(storage.starSystem, "", 0)
+	case *Loadout:
+		fitted := map[string]loadout.Module{}
+		for _, module := range e.Modules {
+			fitted[module.Slot] = module
+		}
+		storage.fitted[e.ShipID] = fitted
+	case *StoredModulesEvent:
+		storage.applyStoredModules(e)
+	case *ModuleStore:
+		storage.store(e.ShipID, e.Slot, e.StoredItem, e.StoredItemLocalised, e.Hot,
+			e.EngineerModifications, e.Level, e.Quality)
+	case *MassModuleStore:
+		for _, item := range e.Items {
+			storage.store(e.ShipID, item.Slot, item.Name, item.NameLocalised, item.Hot,
+				item.EngineerModifications, item.Level, item.Quality)
+		}
+	case *ModuleBuy:
+		if e.StoredItem != "" {
+			storage.store(e.ShipID, e.Slot, e.StoredItem, e.StoredItemLocalised, false, "", 0, 0)
+		}
+	case *ModuleRetrieve:
+		storage.retrieve(e.RetrievedItem, e.EngineerModifications)
+		if e.SwapOutItem != "" {
+			storage.store(e.ShipID, e.Slot, e.SwapOutItem, e.SwapOutItemLocalised, false, "", 0, 0)
+		}
+	case *ModuleSellRemote:
+		storage.remove(e.StorageSlot)
+	case *FetchRemoteModule:
+		for _, module := range storage.Items {
+			if module.StorageSlot != e.StorageSlot {
+				continue
+			}
+			module.StarSystem = storage.starSystem
+			module.StationName = storage.stationName
+			module.MarketID = storage.marketID
+			module.TransferCost = e.TransferCost
+			module.TransferTime = e.TransferTime
+			module.InTransit = e.TransferTime > 0
+			module.ArrivesAt = e.EventTime().Add(time.Duration(e.TransferTime) * time.Second)
+		}
+	}
+}
+
+func (storage *StoredModules) setLocation(system, station string, marketID int64) {
+	storage.starSystem = system
+	storage.stationName = station
+	storage.marketID = marketID
+}
+
+// store adds a module taken out of a ship to storage at the current station.
+func (storage *StoredModules) store(shipID int64, slot, name, localised string, hot bool, blueprint string, level int64, quality float64) {
+	module := &StoredModule{
+		Name:          name,
+		NameLocalised: localised,
+		StarSystem:    storage.starSystem,
+		StationName:   storage.stationName,
+		MarketID:      storage.marketID,
+		Hot:           hot,
+		Engineering:   engineering(blueprint, level, quality),
+	}
+
+	fitted, ok := storage.fitted[shipID][slot]
+	if ok && moduleSymbol(fitted.Item) == moduleSymbol(name) {
+		if fitted.Engineering.BlueprintName != "" {
+			e := fitted.Engineering
+			module.Engineering = &e
+		}
+		delete(storage.fitted[shipID], slot)
+	}
+
+	storage.Items = append(storage.Items, module)
+}
+
+// retrieve removes a module fitted from storage at the current station,
+// preferring one with the same engineering.
+func (storage *StoredModules) retrieve(name, blueprint string) {
+	match := -1
+	for i, module := range storage.Items {
+		if module.MarketID != storage.marketID || module.InTransit || moduleSymbol(module.Name) != moduleSymbol(name) {
+			continue
+		}
+		if match < 0 || module.Engineering != nil && module.Engineering.BlueprintName == blueprint {
+			match = i
+		}
+	}
+	if match >= 0 {
+		storage.Items = append(storage.Items[:match], storage.Items[match+1:]...)
+	}
+}
+
+func (storage *StoredModules) remove(slot int64) {
+	for i, module := range storage.Items {
+		if module.StorageSlot == slot {
+			storage.Items = append(storage.Items[:i], storage.Items[i+1:]...)
+			return
+		}
+	}
+}
+
+// applyStoredModules replaces the storage with the complete list given by a
+// StoredModules event, keeping what is known about each module beyond what
+// the event lists.
+func (storage *StoredModules) applyStoredModules(e *StoredModulesEvent) {
+	previous := storage.Items
+	storage.Items = make([]*StoredModule, 0, len(e.Items))
+
+	for _, item := range e.Items {
+		module := &StoredModule{
+			StorageSlot:   item.StorageSlot,
+			Name:          item.Name,
+			NameLocalised: item.NameLocalised,
+			StarSystem:    item.StarSystem,
+			MarketID:      item.MarketID,
+			InTransit:     item.InTransit,
+			TransferCost:  item.TransferCost,
+			TransferTime:  item.TransferTime,
+			BuyPrice:      item.BuyPrice,
+			Hot:           item.Hot,
+			Engineering:   engineering(item.EngineerModifications, item.Level, item.Quality),
+		}
+		if module.MarketID == 0 && !module.InTransit {
+			// Modules stored here are listed without a location.
+			module.StarSystem = e.StarSystem
+			module.StationName = e.StationName
+			module.MarketID = e.MarketID
+		}
+
+		if i := matchStoredModule(previous, item); i >= 0 {
+			known := previous[i]
+			previous = append(previous[:i], previous[i+1:]...)
+			if module.Engineering != nil && known.Engineering != nil {
+				module.Engineering = known.Engineering
+			}
+			if module.MarketID == known.MarketID && module.StationName == "" {
+				module.StationName = known.StationName
+			}
+			if module.InTransit && known.InTransit {
+				module.StarSystem = known.StarSystem
+				module.StationName = known.StationName
+				module.MarketID = known.MarketID
+				module.ArrivesAt = known.ArrivesAt
+			}
+		}
+		storage.Items = append(storage.Items, module)
+	}
+}
+
+// matchStoredModule returns the index of the module in modules that an item
+// listed by a StoredModules event refers to, or -1 if there is none.
+// Modules stored since the last StoredModules event have no storage slot
+// yet, so they are matched by name and blueprint instead.
+func matchStoredModule(modules []*StoredModule, item StoredModuleItem) int {
+	match := -1
+	for i, module := range modules {
+		if moduleSymbol(module.Name) != moduleSymbol(item.Name) {
+			continue
+		}
+		blueprint := ""
+		if module.Engineering != nil {
+			blueprint = module.Engineering.BlueprintName
+		}
+		if blueprint != item.EngineerModifications {
+			continue
+		}
+		if module.StorageSlot == item.StorageSlot {
+			return i
+		}
+		if module.StorageSlot == 0 && match < 0 {
+			match = i
+		}
+	}
+	return match
+}
+
+// engineering returns the engineering described by the blueprint, level and
+// quality given in storage events, or nil if the module isn't engineered.
+func engineering(blueprint string, level int64, quality float64) *loadout.Engineering {
+	if blueprint == "" {
+		return nil
+	}
+	return &loadout.Engineering{BlueprintName: blueprint, Level: level, Quality: quality}
+}
+
+// moduleSymbol normalises a module name, which is written as
+// "$int_cargorack_size4_class1_name;" in some events and
+// "int_cargorack_size4_class1" in others.
+func moduleSymbol(name string) string {
+	name = strings.ToLower(name)
+	name = strings.TrimPrefix(name, "$")
+	return strings.TrimSuffix(name, "_name;")
+}
+
+// storedModulesEvents are the events that affect StoredModules.
+var storedModulesEvents = []string{
+	"Location", "FSDJump", "Docked", "Undocked", "Loadout", "StoredModules",
+	"ModuleStore", "MassModuleStore", "ModuleBuy", "ModuleRetrieve",
+	"ModuleSellRemote", "FetchRemoteModule",
+}
+
+// GetStoredModules builds the module storage of the most recent commander from the journal files.
+// It will read them from the default log path, which is the Saved Games
+// folder. The full path is:
+//
+//     C:/Users/<Username>/Saved Games/Frontier Developments/Elite Dangerous
+//
+// If that path is not suitable, use GetStoredModulesFromPath.
+func GetStoredModules() (*StoredModules, error) {
+	return GetStoredModulesFromPath(defaultLogPath())
+}
+
+// GetStoredModulesFromPath builds the module storage of the most recent commander from the journal files at the specified path.
+func GetStoredModulesFromPath(logPath string) (*StoredModules, error) {
+	return NewClient(WithLogPath(logPath)).GetStoredModules()
+}
+
+// GetStoredModules builds the module storage of the most recent commander from the journal files.
+func (c *Client) GetStoredModules() (*StoredModules, error) {
+	storage := NewStoredModules()
+
+	commander, err := c.lastCommander()
+	if err != nil {
+		return nil, err
+	}
+
+	journal, err := c.OpenJournal(JournalFilter{Events: storedModulesEvents, Commander: commander})
+	if err != nil {
+		return nil, err
+	}
+	defer journal.Close()
+	for journal.Next() {
+		storage.Apply(journal.Event())
+	}
+	if err := journal.Err(); err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
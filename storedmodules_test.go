@@ -0,0 +1,82 @@
+package elite_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func TestStoredModulesApply(t *testing.T) {
+	storage := elite.NewStoredModules()
+	applyLines(t, storage,
+		`{ "timestamp":"2021-05-20T19:40:02Z", "event":"Location", "Docked":true, "StationName":"Jameson Memorial", "MarketID":128666762, "StarSystem":"Shinrarta Dezhra" }`,
+		`{ "timestamp":"2021-05-20T19:40:03Z", "event":"Loadout", "Ship":"krait_light", "ShipID":15, "Modules":[ { "Slot":"Slot01_Size5", "Item":"int_shieldgenerator_size5_class3_fast", "On":true, "Priority":0, "Engineering":{ "Engineer":"Lei Cheung", "EngineerID":300120, "BlueprintID":128673839, "BlueprintName":"ShieldGenerator_Thermic", "Level":5, "Quality":1.0, "ExperimentalEffect":"special_shield_health", "Modifiers":[ { "Label":"ShieldGenStrength", "Value":55.2, "OriginalValue":40.0, "LessIsGood":0 } ] } } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"ModuleStore", "MarketID":128666762, "Slot":"Slot01_Size5", "StoredItem":"$int_shieldgenerator_size5_class3_fast_name;", "StoredItem_Localised":"Bi-Weave Shield", "Ship":"krait_light", "ShipID":15, "Hot":false, "EngineerModifications":"ShieldGenerator_Thermic", "Level":5, "Quality":1.0 }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"StoredModules", "MarketID":128666762, "StationName":"Jameson Memorial", "StarSystem":"Shinrarta Dezhra", "Items":[ { "Name":"$int_shieldgenerator_size5_class3_fast_name;", "Name_Localised":"Bi-Weave Shield", "StorageSlot":12, "BuyPrice":500000, "Hot":false, "EngineerModifications":"ShieldGenerator_Thermic", "Level":5, "Quality":1.0 }, { "Name":"$hpt_pulselaser_fixed_medium_name;", "StorageSlot":13, "StarSystem":"Sol", "MarketID":128016640, "TransferCost":1200, "TransferTime":900, "BuyPrice":17600, "Hot":false }, { "Name":"$int_cargorack_size4_class1_name;", "StorageSlot":14, "StarSystem":"Sol", "MarketID":128016640, "TransferCost":1000, "TransferTime":900, "BuyPrice":34000, "Hot":false } ] }`,
+		`{ "timestamp":"2021-05-20T19:43:00Z", "event":"FetchRemoteModule", "StorageSlot":13, "StoredItem":"$hpt_pulselaser_fixed_medium_name;", "ServerId":128049381, "TransferCost":1200, "TransferTime":900, "Ship":"krait_light", "ShipID":15 }`,
+		`{ "timestamp":"2021-05-20T19:44:00Z", "event":"ModuleSellRemote", "StorageSlot":14, "SellItem":"$int_cargorack_size4_class1_name;", "ServerId":128064338, "SellPrice":30600, "Ship":"krait_light", "ShipID":15 }`,
+	)
+
+	if len(storage.Items) != 2 {
+		fmt.Printf("Expected 2 stored modules, got %d\n", len(storage.Items))
+		t.FailNow()
+	}
+
+	stations := storage.ByStation()
+	here := stations[128666762]
+	if len(here) != 2 {
+		fmt.Printf("Expected 2 modules at Jameson Memorial, got %d\n", len(here))
+		t.FailNow()
+	}
+
+	laser := here[0]
+	if laser.StorageSlot != 13 || !laser.InTransit || laser.TransferCost != 1200 {
+		fmt.Printf("Incorrect fetched module: %+v\n", laser)
+		t.FailNow()
+	}
+	fetched := time.Date(2021, 5, 20, 19, 43, 0, 0, time.UTC)
+	if laser.Remaining(fetched.Add(5*time.Minute)) != 10*time.Minute || laser.Remaining(fetched.Add(time.Hour)) != 0 {
+		fmt.Println("Incorrect transfer timer")
+		t.FailNow()
+	}
+
+	shield := here[1]
+	if shield.StorageSlot != 12 || shield.StationName != "Jameson Memorial" || shield.Engineering == nil {
+		fmt.Printf("Incorrect stored shield: %+v\n", shield)
+		t.FailNow()
+	}
+	if shield.Engineering.Engineer != "Lei Cheung" || shield.Engineering.ExperimentalEffect != "special_shield_health" || len(shield.Engineering.Modifiers) != 1 {
+		fmt.Println("Stored module should keep the engineering from the ship's loadout")
+		t.FailNow()
+	}
+
+	applyLines(t, storage,
+		`{ "timestamp":"2021-05-20T19:45:00Z", "event":"ModuleRetrieve", "MarketID":128666762, "Slot":"Slot01_Size5", "RetrievedItem":"$int_shieldgenerator_size5_class3_fast_name;", "Ship":"krait_light", "ShipID":15, "Hot":false, "EngineerModifications":"ShieldGenerator_Thermic", "Level":5, "Quality":1.0 }`,
+	)
+	if len(storage.Items) != 1 || storage.Items[0].StorageSlot != 13 {
+		fmt.Println("Retrieved module should be removed from storage")
+		t.FailNow()
+	}
+}
+
+func TestStoredModulesRetrieveSwapOut(t *testing.T) {
+	storage := elite.NewStoredModules()
+	applyLines(t, storage,
+		`{ "timestamp":"2021-05-20T19:40:02Z", "event":"Docked", "StationName":"Jameson Memorial", "MarketID":128666762, "StarSystem":"Shinrarta Dezhra" }`,
+		`{ "timestamp":"2021-05-20T19:40:03Z", "event":"Loadout", "Ship":"krait_light", "ShipID":15, "Modules":[ { "Slot":"Slot01_Size5", "Item":"int_cargorack_size5_class1", "On":true, "Priority":1 } ] }`,
+		`{ "timestamp":"2021-05-20T19:41:00Z", "event":"StoredModules", "MarketID":128666762, "StationName":"Jameson Memorial", "StarSystem":"Shinrarta Dezhra", "Items":[ { "Name":"$int_shieldgenerator_size5_class3_fast_name;", "StorageSlot":12, "BuyPrice":500000, "Hot":false } ] }`,
+		`{ "timestamp":"2021-05-20T19:42:00Z", "event":"ModuleRetrieve", "MarketID":128666762, "Slot":"Slot01_Size5", "RetrievedItem":"$int_shieldgenerator_size5_class3_fast_name;", "Ship":"krait_light", "ShipID":15, "Hot":false, "SwapOutItem":"$int_cargorack_size5_class1_name;", "SwapOutItem_Localised":"Cargo Rack", "Cost":0 }`,
+	)
+
+	if len(storage.Items) != 1 {
+		fmt.Printf("Expected 1 stored module, got %d\n", len(storage.Items))
+		t.FailNow()
+	}
+	rack := storage.Items[0]
+	if rack.Name != "$int_cargorack_size5_class1_name;" || rack.NameLocalised != "Cargo Rack" || rack.MarketID != 128666762 || rack.StationName != "Jameson Memorial" {
+		fmt.Printf("Swapped out module should be stored here: %+v\n", rack)
+		t.FailNow()
+	}
+}
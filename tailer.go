@@ -0,0 +1,197 @@
+package elite
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"io/ioutil"
+	"time"
+)
+
+// DefaultPollInterval is how often a Tailer checks the journal files
+// for new lines unless told otherwise.
+const DefaultPollInterval = 250 * time.Millisecond
+
+// Tailer follows the newest journal file in a log directory and delivers
+// each line written by the game as an Event. When the game starts a new
+// journal file, the Tailer finishes reading the old one and moves on to
+// the new one.
+type Tailer struct {
+	// PollInterval is how often the journal files are checked for new lines.
+	PollInterval time.Duration
+	// SkipExisting starts tailing at the end of the newest journal file
+	// rather than replaying the lines it already contains.
+	SkipExisting bool
+	// Strict stops the Tailer at lines that can't be parsed, reporting a
+	// *ParseError through Err, rather than skipping them.
+	Strict bool
+
+	client *Client
+	file   string
+	offset int64
+	line   int
+	err    error
+}
+
+// NewTailer creates a Tailer that follows the journal files at the specified log path.
+func NewTailer(logPath string) *Tailer {
+	return NewClient(WithLogPath(logPath)).NewTailer()
+}
+
+// NewTailer creates a Tailer that follows the Client's journal files.
+func (c *Client) NewTailer() *Tailer {
+	return &Tailer{
+		PollInterval: DefaultPollInterval,
+		Strict:       c.strict,
+		client:       c,
+	}
+}
+
+// Events starts tailing the journal and returns a channel of events.
+// The channel is closed when the context is cancelled or an error occurs,
+// after which Err reports the error, if any.
+// Events should only be called once per Tailer.
+func (t *Tailer) Events(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+	go t.run(ctx, events)
+	return events
+}
+
+// Err returns the error that stopped the Tailer, if any.
+// It is only valid once the channel returned by Events has been closed.
+func (t *Tailer) Err() error {
+	return t.err
+}
+
+func (t *Tailer) run(ctx context.Context, events chan<- Event) {
+	defer close(events)
+
+	interval := t.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.poll(ctx, events); err != nil {
+			if ctx.Err() == nil {
+				t.err = err
+			}
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll reads any new lines from the current journal file, then from
+// every journal file that was created after it.
+func (t *Tailer) poll(ctx context.Context, events chan<- Event) error {
+	files, err := t.client.ListJournalFiles()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	current := -1
+	for i, file := range files {
+		if file.Name == t.file {
+			current = i
+		}
+	}
+
+	if current < 0 {
+		current = len(files) - 1
+		t.file = files[current].Name
+		t.offset = 0
+		t.line = 0
+		if t.SkipExisting {
+			content, err := fs.ReadFile(t.client.fsys, t.file)
+			if err != nil {
+				return err
+			}
+			end := bytes.LastIndexByte(content, '\n') + 1
+			t.offset = int64(end)
+			t.line = bytes.Count(content[:end], []byte("\n"))
+		}
+	}
+
+	for {
+		if err := t.readLines(ctx, events); err != nil {
+			return err
+		}
+
+		current++
+		if current >= len(files) {
+			return nil
+		}
+		t.file = files[current].Name
+		t.offset = 0
+		t.line = 0
+	}
+}
+
+// readLines sends every complete line after the current offset.
+// A trailing line without a newline is left for the next poll, since the
+// game may still be writing it.
+func (t *Tailer) readLines(ctx context.Context, events chan<- Event) error {
+	journalFile, err := t.client.fsys.Open(t.file)
+	if err != nil {
+		return err
+	}
+	defer journalFile.Close()
+
+	info, err := journalFile.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < t.offset {
+		// The file was truncated or replaced, so start over.
+		t.offset = 0
+		t.line = 0
+	}
+
+	if err := skipTo(journalFile, t.offset); err != nil {
+		return err
+	}
+	content, err := ioutil.ReadAll(journalFile)
+	if err != nil {
+		return err
+	}
+
+	for {
+		end := bytes.IndexByte(content, '\n')
+		if end < 0 {
+			return nil
+		}
+		line := bytes.TrimSpace(content[:end])
+		content = content[end+1:]
+		t.offset += int64(end + 1)
+		t.line++
+
+		if len(line) == 0 {
+			continue
+		}
+		event, err := ParseEvent(line)
+		if err != nil {
+			if t.Strict {
+				return &ParseError{File: t.file, Line: t.line, Text: string(line), Err: err}
+			}
+			t.client.logf("Skipping %s line %d: %v", t.file, t.line, err)
+			continue
+		}
+
+		select {
+		case events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
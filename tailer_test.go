@@ -0,0 +1,89 @@
+package elite_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BenJuan26/elite"
+)
+
+func appendLine(t *testing.T, path, line string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		fmt.Println("Couldn't open journal file: " + err.Error())
+		t.FailNow()
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(line); err != nil {
+		fmt.Println("Couldn't write journal file: " + err.Error())
+		t.FailNow()
+	}
+}
+
+func expectEvent(t *testing.T, events <-chan elite.Event, name string) elite.Event {
+	select {
+	case event, ok := <-events:
+		if !ok {
+			fmt.Println("Event channel closed while waiting for " + name)
+			t.FailNow()
+		}
+		if event.EventName() != name {
+			fmt.Printf("Incorrect event: Expecting %s, got %s\n", name, event.EventName())
+			t.FailNow()
+		}
+		return event
+	case <-time.After(2 * time.Second):
+		fmt.Println("Timed out waiting for " + name)
+		t.FailNow()
+	}
+	return nil
+}
+
+func TestTailer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "elite")
+	if err != nil {
+		fmt.Println("Couldn't create temp dir: " + err.Error())
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+
+	first := filepath.Join(dir, "Journal.2020-01-17T160000.01.log")
+	appendLine(t, first, `{ "timestamp":"2020-01-17T16:00:00Z", "event":"Fileheader", "part":1 }`+"\n")
+
+	tailer := elite.NewTailer(dir)
+	tailer.PollInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	events := tailer.Events(ctx)
+
+	expectEvent(t, events, "Fileheader")
+
+	// A line without a newline is still being written and must not be sent yet.
+	appendLine(t, first, `{ "timestamp":"2020-01-17T16:00:01Z", "event":"Location", `)
+	time.Sleep(50 * time.Millisecond)
+	appendLine(t, first, `"StarSystem":"Sol" }`+"\n")
+	event := expectEvent(t, events, "Location")
+	if location, ok := event.(*elite.Location); !ok || location.StarSystem != "Sol" {
+		fmt.Println("Location event was not decoded as a Location")
+		t.FailNow()
+	}
+
+	second := filepath.Join(dir, "Journal.2020-01-17T180000.02.log")
+	appendLine(t, first, `{ "timestamp":"2020-01-17T17:59:59Z", "event":"Continued", "Part":2 }`+"\n")
+	appendLine(t, second, `{ "timestamp":"2020-01-17T18:00:00Z", "event":"Fileheader", "part":2 }`+"\n")
+	expectEvent(t, events, "Continued")
+	expectEvent(t, events, "Fileheader")
+
+	cancel()
+	for range events {
+	}
+	if tailer.Err() != nil {
+		fmt.Println("Tailer stopped with an error: " + tailer.Err().Error())
+		t.FailNow()
+	}
+}